@@ -0,0 +1,36 @@
+package health_check
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RegisteredServices returns the names of the services registered with the
+// consul agent at consulAddress.
+func RegisteredServices(consulAddress string, timeout time.Duration) (map[string]bool, error) {
+	client := &http.Client{Timeout: timeout}
+	response, err := client.Get(consulAddress + "/v1/agent/services")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("consul responded with %s", response.Status)
+	}
+
+	var agentServices map[string]struct {
+		Service string
+	}
+	if err := json.NewDecoder(response.Body).Decode(&agentServices); err != nil {
+		return nil, err
+	}
+
+	services := map[string]bool{}
+	for _, agentService := range agentServices {
+		services[agentService.Service] = true
+	}
+	return services, nil
+}
@@ -0,0 +1,43 @@
+// This file was generated by counterfeiter
+package fake_checker
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/health_agent/health_check"
+)
+
+type FakeChecker struct {
+	CheckStub        func() health_check.Report
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct{}
+	checkReturns     struct {
+		result1 health_check.Report
+	}
+}
+
+func (fake *FakeChecker) Check() health_check.Report {
+	fake.checkMutex.Lock()
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct{}{})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub()
+	} else {
+		return fake.checkReturns.result1
+	}
+}
+
+func (fake *FakeChecker) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeChecker) CheckReturns(result1 health_check.Report) {
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 health_check.Report
+	}{result1}
+}
+
+var _ health_check.Checker = new(FakeChecker)
@@ -0,0 +1,28 @@
+package health_check
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// NewHandler serves the checker's report as JSON, with a 503 status when any
+// component is unhealthy so that load balancers can use it directly.
+func NewHandler(checker Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" && r.Method != "HEAD" {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		report := checker.Check()
+
+		w.Header().Set("Content-Type", "application/json")
+		if report.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,70 @@
+package health_check_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/health_agent/health_check"
+	"github.com/cloudfoundry-incubator/lattice/health_agent/health_check/fake_checker"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeChecker *fake_checker.FakeChecker
+		handler     http.Handler
+		recorder    *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		fakeChecker = &fake_checker.FakeChecker{}
+		handler = health_check.NewHandler(fakeChecker)
+		recorder = httptest.NewRecorder()
+	})
+
+	It("serves the report as JSON with 200 when every component is healthy", func() {
+		fakeChecker.CheckReturns(health_check.Report{
+			Healthy:    true,
+			Components: []health_check.ComponentStatus{{Name: "etcd", Healthy: true, Processes: 1, Port: 4001}},
+		})
+
+		request, err := http.NewRequest("GET", "/health", nil)
+		Expect(err).ToNot(HaveOccurred())
+		handler.ServeHTTP(recorder, request)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.HeaderMap.Get("Content-Type")).To(Equal("application/json"))
+		Expect(recorder.Body.String()).To(MatchJSON(`{
+			"healthy": true,
+			"components": [{"name": "etcd", "healthy": true, "processes": 1, "port": 4001}]
+		}`))
+	})
+
+	It("responds with 503 when a component is unhealthy", func() {
+		fakeChecker.CheckReturns(health_check.Report{
+			Healthy:    false,
+			Components: []health_check.ComponentStatus{{Name: "rep", Problems: []string{"not running"}}},
+		})
+
+		request, err := http.NewRequest("GET", "/health", nil)
+		Expect(err).ToNot(HaveOccurred())
+		handler.ServeHTTP(recorder, request)
+
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		var report health_check.Report
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+		Expect(report.Components[0].Problems).To(ConsistOf("not running"))
+	})
+
+	It("rejects other methods without checking", func() {
+		request, err := http.NewRequest("POST", "/health", nil)
+		Expect(err).ToNot(HaveOccurred())
+		handler.ServeHTTP(recorder, request)
+
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(fakeChecker.CheckCallCount()).To(Equal(0))
+	})
+})
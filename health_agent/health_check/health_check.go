@@ -0,0 +1,163 @@
+package health_check
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Component is a process that should be running exactly once on the host,
+// optionally listening on a TCP port and registered as a consul service.
+type Component struct {
+	Name          string `json:"name"`
+	Port          int    `json:"port,omitempty"`
+	ConsulService string `json:"consul_service,omitempty"`
+}
+
+type ComponentStatus struct {
+	Name          string   `json:"name"`
+	Healthy       bool     `json:"healthy"`
+	Processes     int      `json:"processes"`
+	Port          int      `json:"port,omitempty"`
+	ConsulService string   `json:"consul_service,omitempty"`
+	Problems      []string `json:"problems,omitempty"`
+}
+
+type Report struct {
+	Healthy    bool              `json:"healthy"`
+	Components []ComponentStatus `json:"components"`
+}
+
+//go:generate counterfeiter -o fake_checker/fake_checker.go . Checker
+type Checker interface {
+	Check() Report
+}
+
+type checker struct {
+	components    []Component
+	procDir       string
+	consulAddress string
+	timeout       time.Duration
+}
+
+// NewChecker returns a checker that finds processes in procDir, normally
+// /proc, and asks the consul agent at consulAddress, such as
+// http://127.0.0.1:8500, for its services. Port and consul checks give up
+// after timeout.
+func NewChecker(components []Component, procDir, consulAddress string, timeout time.Duration) Checker {
+	return &checker{
+		components:    components,
+		procDir:       procDir,
+		consulAddress: consulAddress,
+		timeout:       timeout,
+	}
+}
+
+func (c *checker) Check() Report {
+	processCounts, processErr := CountProcesses(c.procDir)
+
+	var consulServices map[string]bool
+	var consulErr error
+	if c.needsConsul() {
+		consulServices, consulErr = RegisteredServices(c.consulAddress, c.timeout)
+	}
+
+	report := Report{Healthy: true, Components: []ComponentStatus{}}
+	for _, component := range c.components {
+		status := ComponentStatus{
+			Name:          component.Name,
+			Processes:     processCounts[component.Name],
+			Port:          component.Port,
+			ConsulService: component.ConsulService,
+		}
+
+		switch {
+		case processErr != nil:
+			status.Problems = append(status.Problems, "could not list processes: "+processErr.Error())
+		case status.Processes == 0:
+			status.Problems = append(status.Problems, "not running")
+		case status.Processes > 1:
+			status.Problems = append(status.Problems, fmt.Sprintf("more than one was running - expected 1, got %d", status.Processes))
+		}
+
+		if component.Port != 0 && !c.listening(component.Port) {
+			status.Problems = append(status.Problems, fmt.Sprintf("not listening on port %d", component.Port))
+		}
+
+		if component.ConsulService != "" {
+			if consulErr != nil {
+				status.Problems = append(status.Problems, "could not query consul: "+consulErr.Error())
+			} else if !consulServices[component.ConsulService] {
+				status.Problems = append(status.Problems, fmt.Sprintf("not registered with consul as %s", component.ConsulService))
+			}
+		}
+
+		status.Healthy = len(status.Problems) == 0
+		report.Healthy = report.Healthy && status.Healthy
+		report.Components = append(report.Components, status)
+	}
+
+	return report
+}
+
+func (c *checker) needsConsul() bool {
+	for _, component := range c.components {
+		if component.ConsulService != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) listening(port int) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), c.timeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// LoadComponents reads the components listed in every .json file in
+// configDir, in file name order. A component listed in several files, such
+// as consul on a host running both a coordinator and a cell, is only checked
+// once.
+func LoadComponents(configDir string) ([]Component, error) {
+	paths, err := filepath.Glob(filepath.Join(configDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	components := []Component{}
+	seen := map[string]bool{}
+	for _, path := range paths {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var config struct {
+			Components []Component `json:"components"`
+		}
+		if err := json.Unmarshal(contents, &config); err != nil {
+			return nil, fmt.Errorf("invalid config %s: %s", path, err)
+		}
+
+		for _, component := range config.Components {
+			if component.Name == "" {
+				return nil, fmt.Errorf("invalid config %s: component without a name", path)
+			}
+			if seen[component.Name] {
+				continue
+			}
+			seen[component.Name] = true
+			components = append(components, component)
+		}
+	}
+
+	return components, nil
+}
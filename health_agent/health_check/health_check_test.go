@@ -0,0 +1,229 @@
+package health_check_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/health_agent/health_check"
+)
+
+var _ = Describe("HealthCheck", func() {
+	var procDir string
+
+	addProcess := func(pid int, cmdline string) {
+		pidDir := filepath.Join(procDir, strconv.Itoa(pid))
+		Expect(os.MkdirAll(pidDir, 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(pidDir, "cmdline"), []byte(cmdline), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(procDir)
+	})
+
+	Describe("CountProcesses", func() {
+		It("counts processes by the base name of their command", func() {
+			addProcess(1, "/sbin/init\x00")
+			addProcess(42, "etcd\x00--listen-client-urls=http://0.0.0.0:4001\x00")
+			addProcess(43, "/usr/local/bin/rep\x00-stack=lucid64\x00")
+			addProcess(44, "rep\x00")
+			addProcess(2, "")
+			Expect(os.MkdirAll(filepath.Join(procDir, "self"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(procDir, "uptime"), []byte("1.0 1.0"), 0644)).To(Succeed())
+
+			counts, err := health_check.CountProcesses(procDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal(map[string]int{"init": 1, "etcd": 1, "rep": 2}))
+		})
+
+		It("returns an error when procDir cannot be read", func() {
+			_, err := health_check.CountProcesses(filepath.Join(procDir, "missing"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("RegisteredServices", func() {
+		It("returns the services registered with the consul agent", func() {
+			consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/v1/agent/services"))
+				w.Write([]byte(`{"etcd": {"ID": "etcd", "Service": "etcd", "Port": 4001}, "nats-1": {"ID": "nats-1", "Service": "nats", "Port": 4222}}`))
+			}))
+			defer consul.Close()
+
+			services, err := health_check.RegisteredServices(consul.URL, time.Second)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(services).To(Equal(map[string]bool{"etcd": true, "nats": true}))
+		})
+
+		It("returns an error when consul does not respond with OK", func() {
+			consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer consul.Close()
+
+			_, err := health_check.RegisteredServices(consul.URL, time.Second)
+			Expect(err).To(MatchError("consul responded with 500 Internal Server Error"))
+		})
+	})
+
+	Describe("Checker", func() {
+		var (
+			listener       net.Listener
+			listeningPort  int
+			closedPort     int
+			consul         *httptest.Server
+			consulServices string
+		)
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			listeningPort = listener.Addr().(*net.TCPAddr).Port
+
+			closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			closedPort = closedListener.Addr().(*net.TCPAddr).Port
+			closedListener.Close()
+
+			consulServices = `{"etcd": {"ID": "etcd", "Service": "etcd"}}`
+			consul = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(consulServices))
+			}))
+		})
+
+		AfterEach(func() {
+			listener.Close()
+			consul.Close()
+		})
+
+		It("reports running, listening and registered components as healthy", func() {
+			addProcess(42, "etcd\x00")
+			addProcess(43, "metron\x00")
+			components := []health_check.Component{
+				{Name: "etcd", Port: listeningPort, ConsulService: "etcd"},
+				{Name: "metron"},
+			}
+
+			report := health_check.NewChecker(components, procDir, consul.URL, time.Second).Check()
+
+			Expect(report).To(Equal(health_check.Report{
+				Healthy: true,
+				Components: []health_check.ComponentStatus{
+					{Name: "etcd", Healthy: true, Processes: 1, Port: listeningPort, ConsulService: "etcd"},
+					{Name: "metron", Healthy: true, Processes: 1},
+				},
+			}))
+		})
+
+		It("reports each problem with a component", func() {
+			addProcess(43, "rep\x00")
+			addProcess(44, "rep\x00")
+			components := []health_check.Component{
+				{Name: "gnatsd", Port: closedPort, ConsulService: "nats"},
+				{Name: "rep"},
+			}
+
+			report := health_check.NewChecker(components, procDir, consul.URL, time.Second).Check()
+
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Components).To(Equal([]health_check.ComponentStatus{
+				{
+					Name:          "gnatsd",
+					Port:          closedPort,
+					ConsulService: "nats",
+					Problems: []string{
+						"not running",
+						"not listening on port " + strconv.Itoa(closedPort),
+						"not registered with consul as nats",
+					},
+				},
+				{
+					Name:      "rep",
+					Processes: 2,
+					Problems:  []string{"more than one was running - expected 1, got 2"},
+				},
+			}))
+		})
+
+		It("reports components registered with consul as unhealthy when consul cannot be queried", func() {
+			addProcess(42, "etcd\x00")
+			consul.Close()
+			components := []health_check.Component{{Name: "etcd", ConsulService: "etcd"}}
+
+			report := health_check.NewChecker(components, procDir, consul.URL, time.Second).Check()
+
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Components[0].Problems).To(HaveLen(1))
+			Expect(report.Components[0].Problems[0]).To(HavePrefix("could not query consul: "))
+		})
+
+		It("does not query consul when no component is registered with it", func() {
+			addProcess(42, "metron\x00")
+			components := []health_check.Component{{Name: "metron"}}
+
+			report := health_check.NewChecker(components, procDir, "http://127.0.0.1:1", time.Second).Check()
+
+			Expect(report.Healthy).To(BeTrue())
+		})
+	})
+
+	Describe("LoadComponents", func() {
+		var configDir string
+
+		BeforeEach(func() {
+			var err error
+			configDir, err = ioutil.TempDir("", "health-agent")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(configDir)
+		})
+
+		It("reads the components from every JSON file, checking each name once", func() {
+			Expect(ioutil.WriteFile(filepath.Join(configDir, "cell.json"), []byte(`{"components": [{"name": "consul", "port": 8500}, {"name": "rep"}]}`), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(configDir, "coordinator.json"), []byte(`{"components": [{"name": "consul", "port": 8500}, {"name": "etcd", "port": 4001, "consul_service": "etcd"}]}`), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(configDir, "README"), []byte("not a config"), 0644)).To(Succeed())
+
+			components, err := health_check.LoadComponents(configDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(components).To(Equal([]health_check.Component{
+				{Name: "consul", Port: 8500},
+				{Name: "rep"},
+				{Name: "etcd", Port: 4001, ConsulService: "etcd"},
+			}))
+		})
+
+		It("returns an error for invalid JSON", func() {
+			configPath := filepath.Join(configDir, "cell.json")
+			Expect(ioutil.WriteFile(configPath, []byte(`{"components": [`), 0644)).To(Succeed())
+
+			_, err := health_check.LoadComponents(configDir)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("invalid config " + configPath))
+		})
+
+		It("returns an error for a component without a name", func() {
+			configPath := filepath.Join(configDir, "cell.json")
+			Expect(ioutil.WriteFile(configPath, []byte(`{"components": [{"port": 8500}]}`), 0644)).To(Succeed())
+
+			_, err := health_check.LoadComponents(configDir)
+			Expect(err).To(MatchError("invalid config " + configPath + ": component without a name"))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package health_check
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+)
+
+// CountProcesses returns how many processes in procDir, normally /proc, run
+// each command, keyed by the base name of their first argument. Kernel
+// threads, which have no command line, and processes that exit while being
+// read are skipped.
+func CountProcesses(procDir string) (map[string]int, error) {
+	entries, err := ioutil.ReadDir(procDir)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil || !entry.IsDir() {
+			continue
+		}
+
+		cmdline, err := ioutil.ReadFile(filepath.Join(procDir, entry.Name(), "cmdline"))
+		if err != nil || len(cmdline) == 0 {
+			continue
+		}
+
+		command := string(bytes.SplitN(cmdline, []byte{0}, 2)[0])
+		counts[filepath.Base(command)]++
+	}
+
+	return counts, nil
+}
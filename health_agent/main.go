@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/health_agent/health_check"
+)
+
+var listenAddress, configDir, procDir, consulAddress string
+var checkTimeout time.Duration
+
+func init() {
+	flag.StringVar(
+		&listenAddress,
+		"listenAddress",
+		"0.0.0.0:8889",
+		"The address to serve GET /health on",
+	)
+
+	flag.StringVar(
+		&configDir,
+		"configDir",
+		"/var/lattice/config/health-agent",
+		`a directory of JSON files listing the components to check
+    eg. {"components": [{"name": "etcd", "port": 4001, "consul_service": "etcd"}]}
+    `)
+
+	flag.StringVar(
+		&procDir,
+		"procDir",
+		"/proc",
+		"Where to look for the components' processes",
+	)
+
+	flag.StringVar(
+		&consulAddress,
+		"consulAddress",
+		"http://127.0.0.1:8500",
+		"The consul agent to check service registrations with",
+	)
+
+	flag.DurationVar(
+		&checkTimeout,
+		"checkTimeout",
+		time.Second,
+		"How long to wait for a component's port or for consul before reporting it as unhealthy",
+	)
+}
+
+func main() {
+	flag.Parse()
+
+	components, err := health_check.LoadComponents(configDir)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if len(components) == 0 {
+		fmt.Println("No components to check in", configDir)
+		fmt.Println("Usage: health_agent -configDir=/var/lattice/config/health-agent -listenAddress=0.0.0.0:8889")
+		os.Exit(3)
+	}
+
+	checker := health_check.NewChecker(components, procDir, consulAddress, checkTimeout)
+
+	mux := http.NewServeMux()
+	mux.Handle("/health", health_check.NewHandler(checker))
+
+	fmt.Printf("Checking %d components, serving /health on %s\n", len(components), listenAddress)
+	if err := http.ListenAndServe(listenAddress, mux); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,102 @@
+package main_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("health_agent", func() {
+	var healthAgentPath, configDir, procDir string
+
+	BeforeSuite(func() {
+		var err error
+		healthAgentPath, err = gexec.Build("github.com/cloudfoundry-incubator/lattice/health_agent")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterSuite(func() {
+		gexec.CleanupBuildArtifacts()
+	})
+
+	BeforeEach(func() {
+		var err error
+		configDir, err = ioutil.TempDir("", "health-agent")
+		Expect(err).ToNot(HaveOccurred())
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(configDir)
+		os.RemoveAll(procDir)
+	})
+
+	freeAddress := func() string {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		return listener.Addr().String()
+	}
+
+	It("serves the health of the configured components on /health", func() {
+		Expect(ioutil.WriteFile(filepath.Join(configDir, "cell.json"), []byte(`{"components": [{"name": "rep"}, {"name": "executor"}]}`), 0644)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(procDir, "42"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "42", "cmdline"), []byte("rep\x00"), 0644)).To(Succeed())
+
+		listenAddress := freeAddress()
+		command := exec.Command(healthAgentPath, "-listenAddress="+listenAddress, "-configDir="+configDir, "-procDir="+procDir)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Kill()
+		Eventually(session.Out).Should(gbytes.Say("Checking 2 components, serving /health on %s", listenAddress))
+
+		var response *http.Response
+		Eventually(func() error {
+			response, err = http.Get("http://" + listenAddress + "/health")
+			return err
+		}).ShouldNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{
+			"healthy": false,
+			"components": [
+				{"name": "rep", "healthy": true, "processes": 1},
+				{"name": "executor", "healthy": false, "processes": 0, "problems": ["not running"]}
+			]
+		}`))
+	})
+
+	It("prints an error message and exits when there are no components to check", func() {
+		command := exec.Command(healthAgentPath, "-listenAddress="+freeAddress(), "-configDir="+configDir)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(session.Out).Should(gbytes.Say("No components to check in %s", configDir))
+		Eventually(session.Exited).Should(BeClosed())
+		Expect(session.ExitCode()).To(Equal(3))
+	})
+
+	It("exits when a config is invalid", func() {
+		Expect(ioutil.WriteFile(filepath.Join(configDir, "cell.json"), []byte(`{`), 0644)).To(Succeed())
+
+		command := exec.Command(healthAgentPath, "-listenAddress="+freeAddress(), "-configDir="+configDir)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(session.Out).Should(gbytes.Say("invalid config"))
+		Eventually(session.Exited).Should(BeClosed())
+		Expect(session.ExitCode()).To(Equal(1))
+	})
+})
@@ -0,0 +1,13 @@
+package api_server_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestApiServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ApiServer Suite")
+}
@@ -0,0 +1,49 @@
+package api_server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const UnauthorizedError = "Unauthorized"
+
+func tokenAuthWrap(handler http.Handler, tokens []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !validToken(bearerToken(r), tokens) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="ltc"`)
+			writeJSONResponse(w, http.StatusUnauthorized, ErrorResponse{
+				Type:    UnauthorizedError,
+				Message: "a valid API token is required",
+			})
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken reads the token from the Authorization header, or from the
+// access_token query parameter for clients such as EventSource that cannot
+// set headers.
+func bearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimPrefix(authorization, "Bearer ")
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+func validToken(candidate string, tokens []string) bool {
+	if candidate == "" {
+		return false
+	}
+
+	valid := false
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
@@ -0,0 +1,130 @@
+package command_factory
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/api_server"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/lager"
+)
+
+const DefaultServeAddress = "localhost:8888"
+
+type ServeCommandFactory struct {
+	client      lattice.Client
+	target      string
+	ui          terminal.UI
+	exitHandler exit_handler.ExitHandler
+	logger      lager.Logger
+}
+
+func NewServeCommandFactory(client lattice.Client, target string, ui terminal.UI, exitHandler exit_handler.ExitHandler, logger lager.Logger) *ServeCommandFactory {
+	return &ServeCommandFactory{client, target, ui, exitHandler, logger}
+}
+
+func (factory *ServeCommandFactory) MakeServeCommand() cli.Command {
+	var serveFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "address, a",
+			Usage: "Address to listen on",
+			Value: DefaultServeAddress,
+		},
+		cli.StringSliceFlag{
+			Name:  "token, t",
+			Usage: "API token clients must present as 'Authorization: Bearer TOKEN' (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringFlag{
+			Name:  "tokens-file",
+			Usage: "File containing one API token per line",
+		},
+	}
+
+	return cli.Command{
+		Name:      "serve",
+		ShortName: "se",
+		Usage:     "Serves a REST API for managing apps on the targeted lattice",
+		Description: `ltc serve --token=TOKEN [--address=HOST:PORT]
+
+   Endpoints (all require 'Authorization: Bearer TOKEN'):
+     GET    /v1/apps                  lists apps
+     POST   /v1/apps                  creates an app
+     GET    /v1/apps/APP_NAME         shows app status
+     DELETE /v1/apps/APP_NAME         removes an app
+     PUT    /v1/apps/APP_NAME/instances  scales an app, e.g. {"instances": 3}
+     PUT    /v1/apps/APP_NAME/routes     updates routes, e.g. {"routes": [{"hostname_prefix": "web", "port": 8080}]}
+     GET    /v1/apps/APP_NAME/logs    streams logs as server-sent events`,
+		Action: factory.serve,
+		Flags:  serveFlags,
+	}
+}
+
+func (factory *ServeCommandFactory) serve(context *cli.Context) {
+	tokens := context.StringSlice("token")
+	if tokensFile := context.String("tokens-file"); tokensFile != "" {
+		fileTokens, err := readTokensFile(tokensFile)
+		if err != nil {
+			factory.ui.SayError(fmt.Sprintf("Error reading tokens file: %s", err))
+			factory.exitHandler.Exit(exit_codes.GeneralError)
+			return
+		}
+		tokens = append(tokens, fileTokens...)
+	}
+
+	if len(tokens) == 0 {
+		factory.ui.IncorrectUsage("At least one --token or --tokens-file is required")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	handler, err := api_server.New(factory.client, tokens, factory.logger)
+	if err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error starting API server: %s", err))
+		factory.exitHandler.Exit(exit_codes.GeneralError)
+		return
+	}
+
+	listener, err := net.Listen("tcp", context.String("address"))
+	if err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error starting API server: %s", err))
+		factory.exitHandler.Exit(exit_codes.GeneralError)
+		return
+	}
+
+	factory.exitHandler.OnExit(func() {
+		listener.Close()
+	})
+
+	factory.ui.SayLine(fmt.Sprintf("Serving the Lattice API for %s on http://%s", factory.target, listener.Addr()))
+
+	http.Serve(listener, handler)
+}
+
+func readTokensFile(path string) ([]string, error) {
+	tokensFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer tokensFile.Close()
+
+	tokens := []string{}
+	scanner := bufio.NewScanner(tokensFile)
+	for scanner.Scan() {
+		token := strings.TrimSpace(scanner.Text())
+		if token == "" || strings.HasPrefix(token, "#") {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, scanner.Err()
+}
@@ -0,0 +1,111 @@
+package command_factory_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/api_server/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs/fake_log_reader"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock/fakeclock"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		outputBuffer *gbytes.Buffer
+		exitHandler  *fake_exit_handler.FakeExitHandler
+		serveCommand cli.Command
+	)
+
+	BeforeEach(func() {
+		outputBuffer = gbytes.NewBuffer()
+		exitHandler = &fake_exit_handler.FakeExitHandler{}
+
+		client := lattice.NewClient(&fake_app_runner.FakeAppRunner{}, &fake_app_examiner.FakeAppExaminer{}, func() logs.LogReader {
+			return fake_log_reader.NewFakeLogReader()
+		}, fakeclock.NewFakeClock(time.Now()))
+
+		commandFactory := command_factory.NewServeCommandFactory(client, "lattice.example.com", terminal.NewUI(nil, outputBuffer, outputBuffer, nil), exitHandler, lagertest.NewTestLogger("serve"))
+		serveCommand = commandFactory.MakeServeCommand()
+	})
+
+	Describe("ServeCommand", func() {
+		serveAndGetURL := func(args []string) (string, chan struct{}) {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(serveCommand, args)
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Serving the Lattice API for lattice.example.com on "))
+			var serverURL string
+			Eventually(func() string {
+				serverURL = regexp.MustCompile(`http://\S+`).FindString(string(outputBuffer.Contents()))
+				return serverURL
+			}).ShouldNot(BeEmpty())
+
+			return serverURL, commandFinishChan
+		}
+
+		It("serves the API until ltc exits", func() {
+			serverURL, commandFinishChan := serveAndGetURL([]string{"--address=127.0.0.1:0", "--token=sekret"})
+
+			request, err := http.NewRequest("GET", serverURL+"/v1/apps", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Authorization", "Bearer sekret")
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			exitHandler.Exit(130)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("reads tokens from a file", func() {
+			tokensFile, err := ioutil.TempFile("", "tokens")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(tokensFile.Name())
+			tokensFile.WriteString("# dashboard\nfile-token\n\n")
+			tokensFile.Close()
+
+			serverURL, commandFinishChan := serveAndGetURL([]string{"--address=127.0.0.1:0", "--tokens-file=" + tokensFile.Name()})
+
+			response, err := http.Get(serverURL + "/v1/apps?access_token=file-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			exitHandler.Exit(130)
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("requires a token", func() {
+			test_helpers.ExecuteCommandWithArgs(serveCommand, []string{"--address=127.0.0.1:0"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: At least one --token or --tokens-file is required"))
+		})
+
+		It("reports errors reading the tokens file", func() {
+			test_helpers.ExecuteCommandWithArgs(serveCommand, []string{"--tokens-file=/does/not/exist"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error reading tokens file: "))
+		})
+
+		It("reports errors listening on the address", func() {
+			test_helpers.ExecuteCommandWithArgs(serveCommand, []string{"--address=not-an-address", "--token=sekret"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error starting API server: "))
+		})
+	})
+})
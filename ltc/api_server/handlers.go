@@ -0,0 +1,326 @@
+package api_server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/lager"
+	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
+)
+
+const (
+	InvalidJSONError    = "InvalidJSON"
+	InvalidRequestError = "InvalidRequest"
+
+	defaultInstances = 1
+	defaultCPUWeight = 100
+	defaultMemoryMB  = 128
+	defaultDiskMB    = 1024
+	defaultPort      = 8080
+)
+
+type handler struct {
+	client lattice.Client
+	logger lager.Logger
+}
+
+// New returns the HTTP API for the given Lattice client. Every request must
+// carry one of tokens as a bearer token.
+func New(client lattice.Client, tokens []string, logger lager.Logger) (http.Handler, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("at least one API token is required")
+	}
+
+	h := &handler{client: client, logger: logger.Session("api-server")}
+
+	actions := rata.Handlers{
+		ListAppsRoute:        http.HandlerFunc(h.listApps),
+		CreateAppRoute:       http.HandlerFunc(h.createApp),
+		GetAppRoute:          http.HandlerFunc(h.getApp),
+		RemoveAppRoute:       http.HandlerFunc(h.removeApp),
+		ScaleAppRoute:        http.HandlerFunc(h.scaleApp),
+		UpdateAppRoutesRoute: http.HandlerFunc(h.updateAppRoutes),
+		AppLogsRoute:         http.HandlerFunc(h.appLogs),
+	}
+
+	router, err := rata.NewRouter(Routes, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenAuthWrap(router, tokens), nil
+}
+
+func (h *handler) listApps(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	apps, err := h.client.ListApps(ctx)
+	if err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apps)
+}
+
+func (h *handler) getApp(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	appInfo, err := h.client.AppStatus(ctx, r.FormValue(":name"))
+	if err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, appInfo)
+}
+
+func (h *handler) createApp(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	var request CreateAppRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidJSONError, err.Error())
+		return
+	}
+
+	params, err := createDockerAppParams(request)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidRequestError, err.Error())
+		return
+	}
+
+	if err := h.client.CreateApp(ctx, params); err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	h.logger.Info("created-app", lager.Data{"name": params.Name, "instances": params.Instances})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) removeApp(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	name := r.FormValue(":name")
+	if err := h.client.RemoveApp(ctx, name); err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	h.logger.Info("removed-app", lager.Data{"name": name})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) scaleApp(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	name := r.FormValue(":name")
+
+	var request ScaleAppRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidJSONError, err.Error())
+		return
+	}
+	if request.Instances == nil || *request.Instances < 0 {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidRequestError, "instances must be a non-negative integer")
+		return
+	}
+
+	if err := h.client.ScaleApp(ctx, name, *request.Instances); err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	h.logger.Info("scaled-app", lager.Data{"name": name, "instances": *request.Instances})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) updateAppRoutes(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	name := r.FormValue(":name")
+
+	var request UpdateAppRoutesRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidJSONError, err.Error())
+		return
+	}
+	if len(request.Routes) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, InvalidRequestError, "at least one route is required")
+		return
+	}
+
+	if err := h.client.UpdateAppRoutes(ctx, name, routeOverrides(request.Routes)); err != nil {
+		h.writeClientError(w, err)
+		return
+	}
+
+	h.logger.Info("updated-app-routes", lager.Data{"name": name, "routes": request.Routes})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) appLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := newRequestContext(w)
+	defer ctx.release()
+
+	name := r.FormValue(":name")
+	logger := h.logger.Session("app-logs", lager.Data{"name": name})
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeErrorResponse(w, http.StatusInternalServerError, string(lattice.ErrorTypeUnknown), "streaming is not supported")
+		return
+	}
+
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	eventID := 0
+	writeEvent := func(name string, payload interface{}) {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return
+		}
+
+		sse.Event{ID: strconv.Itoa(eventID), Name: name, Data: data}.Write(w)
+		flusher.Flush()
+		eventID++
+	}
+
+	err := h.client.TailLogs(ctx, name, func(logMessage *events.LogMessage) {
+		writeEvent("log", newLogMessageResponse(logMessage))
+	}, func(err error) {
+		writeEvent("error", ErrorResponse{Type: string(lattice.ErrorTypeUnknown), Message: err.Error()})
+	})
+	if err != nil && !lattice.IsCanceled(err) {
+		logger.Error("failed-to-tail-logs", err)
+	}
+}
+
+func (h *handler) writeClientError(w http.ResponseWriter, err error) {
+	latticeErr, ok := err.(*lattice.Error)
+	if !ok {
+		latticeErr = &lattice.Error{Type: lattice.ErrorTypeUnknown, Message: err.Error()}
+	}
+
+	if latticeErr.Type == lattice.ErrorTypeUnknown {
+		h.logger.Error("lattice-request-failed", err)
+	}
+
+	writeErrorResponse(w, statusCodeForErrorType(latticeErr.Type), string(latticeErr.Type), latticeErr.Message)
+}
+
+func statusCodeForErrorType(errorType lattice.ErrorType) int {
+	switch errorType {
+	case lattice.ErrorTypeAppNotFound:
+		return http.StatusNotFound
+	case lattice.ErrorTypeAppAlreadyExists, lattice.ErrorTypePlacement:
+		return http.StatusConflict
+	case lattice.ErrorTypeInvalidRequest:
+		return http.StatusBadRequest
+	case lattice.ErrorTypeUnauthorized:
+		return http.StatusBadGateway
+	case lattice.ErrorTypeCanceled:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+func createDockerAppParams(request CreateAppRequest) (docker_app_runner.CreateDockerAppParams, error) {
+	switch {
+	case request.Name == "":
+		return docker_app_runner.CreateDockerAppParams{}, errors.New("name is required")
+	case request.DockerImage == "":
+		return docker_app_runner.CreateDockerAppParams{}, errors.New("docker_image is required")
+	case request.StartCommand == "":
+		return docker_app_runner.CreateDockerAppParams{}, errors.New("start_command is required")
+	case request.CPUWeight > 100:
+		return docker_app_runner.CreateDockerAppParams{}, errors.New("cpu_weight must be between 1 and 100")
+	case len(request.Ports) > 1 && request.MonitoredPort == 0 && !request.NoMonitor:
+		return docker_app_runner.CreateDockerAppParams{}, errors.New("monitored_port is required when exposing multiple ports")
+	}
+
+	params := docker_app_runner.CreateDockerAppParams{
+		Name:                 request.Name,
+		DockerImagePath:      request.DockerImage,
+		StartCommand:         request.StartCommand,
+		AppArgs:              request.Args,
+		EnvironmentVariables: request.Env,
+		Privileged:           request.RunAsRoot,
+		Monitor:              !request.NoMonitor,
+		Instances:            defaultInt(request.Instances, defaultInstances),
+		CPUWeight:            uint(defaultInt(int(request.CPUWeight), defaultCPUWeight)),
+		MemoryMB:             defaultInt(request.MemoryMB, defaultMemoryMB),
+		DiskMB:               defaultInt(request.DiskMB, defaultDiskMB),
+		WorkingDir:           request.WorkingDir,
+		RouteOverrides:       routeOverrides(request.Routes),
+	}
+	if params.EnvironmentVariables == nil {
+		params.EnvironmentVariables = map[string]string{}
+	}
+	if params.WorkingDir == "" {
+		params.WorkingDir = "/"
+	}
+
+	params.Ports.Exposed = request.Ports
+	if len(params.Ports.Exposed) == 0 {
+		params.Ports.Exposed = []uint16{defaultPort}
+	}
+	if params.Monitor {
+		params.Ports.Monitored = request.MonitoredPort
+		if params.Ports.Monitored == 0 {
+			params.Ports.Monitored = params.Ports.Exposed[0]
+		}
+	}
+
+	return params, nil
+}
+
+func routeOverrides(routes []Route) docker_app_runner.RouteOverrides {
+	var overrides docker_app_runner.RouteOverrides
+	for _, route := range routes {
+		overrides = append(overrides, docker_app_runner.RouteOverride{HostnamePrefix: route.HostnamePrefix, Port: route.Port})
+	}
+	return overrides
+}
+
+func defaultInt(value, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func writeErrorResponse(w http.ResponseWriter, statusCode int, errorType, message string) {
+	writeJSONResponse(w, statusCode, ErrorResponse{Type: errorType, Message: message})
+}
+
+func writeJSONResponse(w http.ResponseWriter, statusCode int, jsonObj interface{}) {
+	jsonBytes, err := json.Marshal(jsonObj)
+	if err != nil {
+		panic("Unable to encode JSON: " + err.Error())
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(jsonBytes)))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	w.Write(jsonBytes)
+}
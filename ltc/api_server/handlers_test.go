@@ -0,0 +1,306 @@
+package api_server_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/api_server"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs/fake_log_reader"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock/fakeclock"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("ApiServer", func() {
+	var (
+		appRunner   *fake_app_runner.FakeAppRunner
+		appExaminer *fake_app_examiner.FakeAppExaminer
+		logReader   *fake_log_reader.FakeLogReader
+		server      *httptest.Server
+	)
+
+	doRequest := func(method, path, token, body string) *http.Response {
+		request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		return response
+	}
+
+	decodeBody := func(response *http.Response, target interface{}) {
+		defer response.Body.Close()
+		Expect(json.NewDecoder(response.Body).Decode(target)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		appRunner = &fake_app_runner.FakeAppRunner{}
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		logReader = fake_log_reader.NewFakeLogReader()
+
+		client := lattice.NewClient(appRunner, appExaminer, func() logs.LogReader { return logReader }, fakeclock.NewFakeClock(time.Now()))
+		handler, err := api_server.New(client, []string{"sekret", "other-token"}, lagertest.NewTestLogger("api-server"))
+		Expect(err).ToNot(HaveOccurred())
+
+		server = httptest.NewServer(handler)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requires at least one token", func() {
+		_, err := api_server.New(nil, []string{}, lagertest.NewTestLogger("api-server"))
+		Expect(err).To(MatchError("at least one API token is required"))
+	})
+
+	Describe("authentication", func() {
+		It("rejects requests without a token", func() {
+			response := doRequest("GET", "/v1/apps", "", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			var errorResponse api_server.ErrorResponse
+			decodeBody(response, &errorResponse)
+			Expect(errorResponse.Type).To(Equal(api_server.UnauthorizedError))
+			Expect(appExaminer.ListAppsCallCount()).To(BeZero())
+		})
+
+		It("rejects requests with an unknown token", func() {
+			response := doRequest("GET", "/v1/apps", "guess", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("accepts any of the configured tokens", func() {
+			Expect(doRequest("GET", "/v1/apps", "other-token", "").StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("accepts the token as a query parameter", func() {
+			Expect(doRequest("GET", "/v1/apps?access_token=sekret", "", "").StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("GET /v1/apps", func() {
+		It("lists the apps", func() {
+			appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app", DesiredInstances: 2}}, nil)
+
+			response := doRequest("GET", "/v1/apps", "sekret", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			var apps []app_examiner.AppInfo
+			decodeBody(response, &apps)
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].ProcessGuid).To(Equal("cool-web-app"))
+			Expect(apps[0].DesiredInstances).To(Equal(2))
+		})
+
+		It("returns a 500 when the receptor fails", func() {
+			appExaminer.ListAppsReturns(nil, errors.New("receptor down"))
+
+			response := doRequest("GET", "/v1/apps", "sekret", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			var errorResponse api_server.ErrorResponse
+			decodeBody(response, &errorResponse)
+			Expect(errorResponse).To(Equal(api_server.ErrorResponse{Type: string(lattice.ErrorTypeUnknown), Message: "receptor down"}))
+		})
+	})
+
+	Describe("GET /v1/apps/:name", func() {
+		It("returns the app status", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{ProcessGuid: "cool-web-app"}, nil)
+
+			response := doRequest("GET", "/v1/apps/cool-web-app", "sekret", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(appExaminer.AppStatusArgsForCall(0)).To(Equal("cool-web-app"))
+		})
+
+		It("returns a 404 when the app does not exist", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			response := doRequest("GET", "/v1/apps/cool-web-app", "sekret", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			var errorResponse api_server.ErrorResponse
+			decodeBody(response, &errorResponse)
+			Expect(errorResponse.Type).To(Equal(string(lattice.ErrorTypeAppNotFound)))
+		})
+	})
+
+	Describe("POST /v1/apps", func() {
+		It("creates the app with defaults for unspecified fields", func() {
+			response := doRequest("POST", "/v1/apps", "sekret", `{
+				"name": "cool-web-app",
+				"docker_image": "superfun/app",
+				"start_command": "/start-me",
+				"args": ["--fast"],
+				"env": {"FOO": "bar"},
+				"routes": [{"hostname_prefix": "cool", "port": 8080}]
+			}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+			Expect(appRunner.CreateDockerAppCallCount()).To(Equal(1))
+			Expect(appRunner.CreateDockerAppArgsForCall(0)).To(Equal(docker_app_runner.CreateDockerAppParams{
+				Name:                 "cool-web-app",
+				DockerImagePath:      "superfun/app",
+				StartCommand:         "/start-me",
+				AppArgs:              []string{"--fast"},
+				EnvironmentVariables: map[string]string{"FOO": "bar"},
+				Monitor:              true,
+				Instances:            1,
+				CPUWeight:            100,
+				MemoryMB:             128,
+				DiskMB:               1024,
+				Ports:                docker_app_runner.PortConfig{Monitored: 8080, Exposed: []uint16{8080}},
+				WorkingDir:           "/",
+				RouteOverrides:       docker_app_runner.RouteOverrides{{HostnamePrefix: "cool", Port: 8080}},
+			}))
+		})
+
+		It("returns a 409 when the app already exists", func() {
+			appRunner.CreateDockerAppReturns(docker_app_runner.ExistingAppError("cool-web-app"))
+
+			response := doRequest("POST", "/v1/apps", "sekret", `{"name": "cool-web-app", "docker_image": "superfun/app", "start_command": "/start-me"}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("returns a 400 for invalid JSON", func() {
+			response := doRequest("POST", "/v1/apps", "sekret", `{"name":`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			var errorResponse api_server.ErrorResponse
+			decodeBody(response, &errorResponse)
+			Expect(errorResponse.Type).To(Equal(api_server.InvalidJSONError))
+		})
+
+		It("returns a 400 when required fields are missing", func() {
+			response := doRequest("POST", "/v1/apps", "sekret", `{"name": "cool-web-app", "docker_image": "superfun/app"}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			var errorResponse api_server.ErrorResponse
+			decodeBody(response, &errorResponse)
+			Expect(errorResponse).To(Equal(api_server.ErrorResponse{Type: api_server.InvalidRequestError, Message: "start_command is required"}))
+			Expect(appRunner.CreateDockerAppCallCount()).To(BeZero())
+		})
+
+		It("requires a monitored port when exposing several ports", func() {
+			response := doRequest("POST", "/v1/apps", "sekret", `{"name": "a", "docker_image": "b", "start_command": "c", "ports": [80, 8080]}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PUT /v1/apps/:name/instances", func() {
+		It("scales the app", func() {
+			response := doRequest("PUT", "/v1/apps/cool-web-app/instances", "sekret", `{"instances": 0}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+			name, instances := appRunner.ScaleAppArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(instances).To(Equal(0))
+		})
+
+		It("requires the number of instances", func() {
+			response := doRequest("PUT", "/v1/apps/cool-web-app/instances", "sekret", `{}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			Expect(appRunner.ScaleAppCallCount()).To(BeZero())
+		})
+
+		It("returns a 404 when the app is not started", func() {
+			appRunner.ScaleAppReturns(docker_app_runner.AppNotStartedError("cool-web-app"))
+
+			response := doRequest("PUT", "/v1/apps/cool-web-app/instances", "sekret", `{"instances": 3}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("PUT /v1/apps/:name/routes", func() {
+		It("updates the routes", func() {
+			response := doRequest("PUT", "/v1/apps/cool-web-app/routes", "sekret", `{"routes": [{"hostname_prefix": "api", "port": 9000}]}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+			name, routes := appRunner.UpdateAppRoutesArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(routes).To(Equal(docker_app_runner.RouteOverrides{{HostnamePrefix: "api", Port: 9000}}))
+		})
+
+		It("requires at least one route", func() {
+			response := doRequest("PUT", "/v1/apps/cool-web-app/routes", "sekret", `{"routes": []}`)
+
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("DELETE /v1/apps/:name", func() {
+		It("removes the app", func() {
+			response := doRequest("DELETE", "/v1/apps/cool-web-app", "sekret", "")
+
+			Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+			Expect(appRunner.RemoveAppArgsForCall(0)).To(Equal("cool-web-app"))
+		})
+	})
+
+	Describe("GET /v1/apps/:name/logs", func() {
+		It("streams log messages as server-sent events", func() {
+			logReader.AddLog(&events.LogMessage{
+				Message:        []byte("hello from the app"),
+				MessageType:    events.LogMessage_OUT.Enum(),
+				Timestamp:      int64Ptr(time.Date(2015, 4, 1, 13, 14, 15, 0, time.UTC).UnixNano()),
+				SourceType:     stringPtr("APP"),
+				SourceInstance: stringPtr("1"),
+			})
+			logReader.AddError(errors.New("lost connection"))
+
+			response := doRequest("GET", "/v1/apps/cool-web-app/logs", "sekret", "")
+			defer response.Body.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+
+			scanner := bufio.NewScanner(bytes.NewReader(body))
+			lines := []string{}
+			for scanner.Scan() {
+				lines = append(lines, scanner.Text())
+			}
+			Expect(lines).To(ContainElement("event: log"))
+			Expect(lines).To(ContainElement(`data: {"timestamp":"2015-04-01T13:14:15Z","source_type":"APP","source_instance":"1","message_type":"OUT","message":"hello from the app"}`))
+			Expect(lines).To(ContainElement("event: error"))
+			Expect(lines).To(ContainElement(`data: {"type":"UnknownError","message":"lost connection"}`))
+			Expect(logReader.GetAppGuid()).To(Equal("cool-web-app"))
+		})
+	})
+})
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,53 @@
+package api_server
+
+import (
+	"errors"
+	"net/http"
+)
+
+var errClientDisconnected = errors.New("client disconnected")
+
+// requestContext is cancelled when the client goes away, so abandoned
+// requests stop waiting on the receptor and log streams are torn down.
+type requestContext struct {
+	done chan struct{}
+	stop chan struct{}
+}
+
+func newRequestContext(w http.ResponseWriter) *requestContext {
+	ctx := &requestContext{
+		done: make(chan struct{}),
+		stop: make(chan struct{}),
+	}
+
+	if closeNotifier, ok := w.(http.CloseNotifier); ok {
+		closed := closeNotifier.CloseNotify()
+		go func() {
+			select {
+			case <-closed:
+				close(ctx.done)
+			case <-ctx.stop:
+			}
+		}()
+	}
+
+	return ctx
+}
+
+func (c *requestContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *requestContext) Err() error {
+	select {
+	case <-c.done:
+		return errClientDisconnected
+	default:
+		return nil
+	}
+}
+
+// release stops watching for the client going away once the handler is done.
+func (c *requestContext) release() {
+	close(c.stop)
+}
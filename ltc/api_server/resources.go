@@ -0,0 +1,61 @@
+package api_server
+
+import (
+	"time"
+
+	"github.com/cloudfoundry/noaa/events"
+)
+
+type CreateAppRequest struct {
+	Name          string            `json:"name"`
+	DockerImage   string            `json:"docker_image"`
+	StartCommand  string            `json:"start_command"`
+	Args          []string          `json:"args"`
+	Env           map[string]string `json:"env"`
+	Instances     int               `json:"instances"`
+	CPUWeight     uint              `json:"cpu_weight"`
+	MemoryMB      int               `json:"memory_mb"`
+	DiskMB        int               `json:"disk_mb"`
+	Ports         []uint16          `json:"ports"`
+	MonitoredPort uint16            `json:"monitored_port"`
+	NoMonitor     bool              `json:"no_monitor"`
+	Routes        []Route           `json:"routes"`
+	WorkingDir    string            `json:"working_dir"`
+	RunAsRoot     bool              `json:"run_as_root"`
+}
+
+type ScaleAppRequest struct {
+	Instances *int `json:"instances"`
+}
+
+type UpdateAppRoutesRequest struct {
+	Routes []Route `json:"routes"`
+}
+
+type Route struct {
+	HostnamePrefix string `json:"hostname_prefix"`
+	Port           uint16 `json:"port"`
+}
+
+type ErrorResponse struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type LogMessageResponse struct {
+	Timestamp      time.Time `json:"timestamp"`
+	SourceType     string    `json:"source_type"`
+	SourceInstance string    `json:"source_instance"`
+	MessageType    string    `json:"message_type"`
+	Message        string    `json:"message"`
+}
+
+func newLogMessageResponse(logMessage *events.LogMessage) LogMessageResponse {
+	return LogMessageResponse{
+		Timestamp:      time.Unix(0, logMessage.GetTimestamp()).UTC(),
+		SourceType:     logMessage.GetSourceType(),
+		SourceInstance: logMessage.GetSourceInstance(),
+		MessageType:    logMessage.GetMessageType().String(),
+		Message:        string(logMessage.GetMessage()),
+	}
+}
@@ -0,0 +1,23 @@
+package api_server
+
+import "github.com/tedsuo/rata"
+
+const (
+	ListAppsRoute        = "ListApps"
+	CreateAppRoute       = "CreateApp"
+	GetAppRoute          = "GetApp"
+	RemoveAppRoute       = "RemoveApp"
+	ScaleAppRoute        = "ScaleApp"
+	UpdateAppRoutesRoute = "UpdateAppRoutes"
+	AppLogsRoute         = "AppLogs"
+)
+
+var Routes = rata.Routes{
+	{Path: "/v1/apps", Method: "GET", Name: ListAppsRoute},
+	{Path: "/v1/apps", Method: "POST", Name: CreateAppRoute},
+	{Path: "/v1/apps/:name", Method: "GET", Name: GetAppRoute},
+	{Path: "/v1/apps/:name", Method: "DELETE", Name: RemoveAppRoute},
+	{Path: "/v1/apps/:name/instances", Method: "PUT", Name: ScaleAppRoute},
+	{Path: "/v1/apps/:name/routes", Method: "PUT", Name: UpdateAppRoutesRoute},
+	{Path: "/v1/apps/:name/logs", Method: "GET", Name: AppLogsRoute},
+}
@@ -1,53 +1,61 @@
 package app_examiner
 
 import (
-	"errors"
 	"sort"
+	"strconv"
 
 	"github.com/cloudfoundry-incubator/lattice/ltc/route_helpers"
 	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry-incubator/runtime-schema/models"
 )
 
 const AppNotFoundErrorMessage = "App not found."
 
 type EnvironmentVariable struct {
-	Name  string
-	Value string
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 type AppInfo struct {
-	ProcessGuid            string
-	DesiredInstances       int
-	ActualRunningInstances int
-	Stack                  string
-	EnvironmentVariables   []EnvironmentVariable
-	StartTimeout           uint
-	DiskMB                 int
-	MemoryMB               int
-	CPUWeight              uint
-	Ports                  []uint16
-	Routes                 route_helpers.AppRoutes
-	LogGuid                string
-	LogSource              string
-	Annotation             string
-	ActualInstances        []InstanceInfo
+	ProcessGuid            string                  `json:"process_guid"`
+	DesiredInstances       int                     `json:"desired_instances"`
+	ActualRunningInstances int                     `json:"actual_running_instances"`
+	Stack                  string                  `json:"stack"`
+	EnvironmentVariables   []EnvironmentVariable   `json:"env"`
+	StartTimeout           uint                    `json:"start_timeout"`
+	DiskMB                 int                     `json:"disk_mb"`
+	MemoryMB               int                     `json:"memory_mb"`
+	CPUWeight              uint                    `json:"cpu_weight"`
+	Ports                  []uint16                `json:"ports"`
+	Routes                 route_helpers.AppRoutes `json:"routes"`
+	LogGuid                string                  `json:"log_guid"`
+	LogSource              string                  `json:"log_source"`
+	Annotation             string                  `json:"annotation,omitempty"`
+	RootFS                 string                  `json:"rootfs,omitempty"`
+	StartCommand           string                  `json:"start_command,omitempty"`
+	StartArgs              []string                `json:"start_args,omitempty"`
+	WorkingDir             string                  `json:"working_dir,omitempty"`
+	RunAsRoot              bool                    `json:"run_as_root,omitempty"`
+	MonitoredPort          uint16                  `json:"monitored_port,omitempty"`
+	ActualInstances        []InstanceInfo          `json:"instances"`
 }
 
 type PortMapping struct {
-	HostPort      uint16
-	ContainerPort uint16
+	HostPort      uint16 `json:"host_port"`
+	ContainerPort uint16 `json:"container_port"`
 }
 
 type InstanceInfo struct {
-	InstanceGuid   string
-	CellID         string
-	Index          int
-	Ip             string
-	Ports          []PortMapping
-	State          string
-	Since          int64
-	PlacementError string
-	CrashCount     int
+	InstanceGuid   string        `json:"instance_guid"`
+	CellID         string        `json:"cell_id"`
+	Index          int           `json:"index"`
+	Ip             string        `json:"ip"`
+	Ports          []PortMapping `json:"ports"`
+	State          string        `json:"state"`
+	Since          int64         `json:"since"`
+	PlacementError string        `json:"placement_error,omitempty"`
+	CrashCount     int           `json:"crash_count"`
+	CrashReason    string        `json:"crash_reason,omitempty"`
 }
 
 type instanceInfoSortableByIndex []InstanceInfo
@@ -65,10 +73,46 @@ func (x instanceInfoSortableByIndex) Swap(i, j int) {
 }
 
 type CellInfo struct {
-	CellID           string
-	RunningInstances int
-	ClaimedInstances int
-	Missing          bool
+	CellID              string             `json:"cell_id"`
+	Stack               string             `json:"stack"`
+	Zone                string             `json:"zone"`
+	Capacity            CellResources      `json:"capacity"`
+	Reserved            CellResources      `json:"reserved"`
+	RunningInstances    int                `json:"running_instances"`
+	ClaimedInstances    int                `json:"claimed_instances"`
+	EvacuatingInstances int                `json:"evacuating_instances"`
+	Instances           []CellInstanceInfo `json:"instances"`
+	Missing             bool               `json:"missing"`
+}
+
+type CellResources struct {
+	MemoryMB   int `json:"memory_mb"`
+	DiskMB     int `json:"disk_mb"`
+	Containers int `json:"containers"`
+}
+
+type CellInstanceInfo struct {
+	ProcessGuid string `json:"process_guid"`
+	Index       int    `json:"index"`
+	State       string `json:"state"`
+	Evacuating  bool   `json:"evacuating"`
+}
+
+type cellInstanceInfoSortable []CellInstanceInfo
+
+func (x cellInstanceInfoSortable) Len() int {
+	return len(x)
+}
+
+func (x cellInstanceInfoSortable) Less(i, j int) bool {
+	if x[i].ProcessGuid == x[j].ProcessGuid {
+		return x[i].Index < x[j].Index
+	}
+	return x[i].ProcessGuid < x[j].ProcessGuid
+}
+
+func (x cellInstanceInfoSortable) Swap(i, j int) {
+	x[i], x[j] = x[j], x[i]
 }
 
 //go:generate counterfeiter -o fake_app_examiner/fake_app_examiner.go . AppExaminer
@@ -94,7 +138,16 @@ func (e *appExaminer) ListCells() ([]CellInfo, error) {
 	}
 
 	for _, cell := range cellList {
-		allCells[cell.CellID] = &CellInfo{CellID: cell.CellID}
+		allCells[cell.CellID] = &CellInfo{
+			CellID: cell.CellID,
+			Stack:  cell.Stack,
+			Zone:   cell.Zone,
+			Capacity: CellResources{
+				MemoryMB:   cell.Capacity.MemoryMB,
+				DiskMB:     cell.Capacity.DiskMB,
+				Containers: cell.Capacity.Containers,
+			},
+		}
 	}
 
 	actualLRPs, err := e.receptorClient.ActualLRPs()
@@ -102,21 +155,54 @@ func (e *appExaminer) ListCells() ([]CellInfo, error) {
 		return nil, err
 	}
 
+	desiredLRPs, err := e.receptorClient.DesiredLRPs()
+	if err != nil {
+		return nil, err
+	}
+
+	desiredLRPsByProcessGuid := make(map[string]receptor.DesiredLRPResponse)
+	for _, desiredLRP := range desiredLRPs {
+		desiredLRPsByProcessGuid[desiredLRP.ProcessGuid] = desiredLRP
+	}
+
 	for _, actualLRP := range actualLRPs {
 		if actualLRP.State == receptor.ActualLRPStateUnclaimed {
 			continue
 		}
 
-		_, ok := allCells[actualLRP.CellID]
+		cellInfo, ok := allCells[actualLRP.CellID]
 		if !ok {
-			allCells[actualLRP.CellID] = &CellInfo{CellID: actualLRP.CellID, Missing: true}
+			cellInfo = &CellInfo{CellID: actualLRP.CellID, Missing: true}
+			allCells[actualLRP.CellID] = cellInfo
 		}
 
 		if actualLRP.State == receptor.ActualLRPStateRunning {
-			allCells[actualLRP.CellID].RunningInstances++
+			cellInfo.RunningInstances++
 		} else if actualLRP.State == receptor.ActualLRPStateClaimed {
-			allCells[actualLRP.CellID].ClaimedInstances++
+			cellInfo.ClaimedInstances++
+		}
+
+		if actualLRP.Evacuating {
+			cellInfo.EvacuatingInstances++
 		}
+
+		if actualLRP.State == receptor.ActualLRPStateRunning || actualLRP.State == receptor.ActualLRPStateClaimed {
+			desiredLRP := desiredLRPsByProcessGuid[actualLRP.ProcessGuid]
+			cellInfo.Reserved.MemoryMB += desiredLRP.MemoryMB
+			cellInfo.Reserved.DiskMB += desiredLRP.DiskMB
+			cellInfo.Reserved.Containers++
+		}
+
+		cellInfo.Instances = append(cellInfo.Instances, CellInstanceInfo{
+			ProcessGuid: actualLRP.ProcessGuid,
+			Index:       actualLRP.Index,
+			State:       string(actualLRP.State),
+			Evacuating:  actualLRP.Evacuating,
+		})
+	}
+
+	for _, cellInfo := range allCells {
+		sort.Sort(cellInstanceInfoSortable(cellInfo.Instances))
 	}
 
 	return sortCells(allCells), nil
@@ -140,8 +226,7 @@ func (e *appExaminer) ListApps() ([]AppInfo, error) {
 func (e *appExaminer) AppStatus(appName string) (AppInfo, error) {
 	desiredLRP, err := e.receptorClient.GetDesiredLRP(appName)
 	if err != nil {
-		receptorError := err.(receptor.Error)
-		if receptorError.Type == receptor.DesiredLRPNotFound {
+		if receptorError, ok := err.(receptor.Error); ok && receptorError.Type == receptor.DesiredLRPNotFound {
 			desiredLRP = receptor.DesiredLRPResponse{}
 		} else {
 			return AppInfo{}, err
@@ -157,7 +242,7 @@ func (e *appExaminer) AppStatus(appName string) (AppInfo, error) {
 
 	appInfoPtr, ok := appMap[appName]
 	if !ok {
-		return AppInfo{}, errors.New(AppNotFoundErrorMessage)
+		return AppInfo{}, newAppNotFoundError(appName)
 	}
 
 	return *appInfoPtr, nil
@@ -182,6 +267,15 @@ func mergeDesiredActualLRPs(desiredLRPs []receptor.DesiredLRPResponse, actualLRP
 			LogGuid:              desiredLRP.LogGuid,
 			LogSource:            desiredLRP.LogSource,
 			Annotation:           desiredLRP.Annotation,
+			RootFS:               desiredLRP.RootFSPath,
+			MonitoredPort:        monitoredPort(desiredLRP.Monitor),
+		}
+
+		if runAction, ok := desiredLRP.Action.(*models.RunAction); ok {
+			appMap[desiredLRP.ProcessGuid].StartCommand = runAction.Path
+			appMap[desiredLRP.ProcessGuid].StartArgs = runAction.Args
+			appMap[desiredLRP.ProcessGuid].WorkingDir = runAction.Dir
+			appMap[desiredLRP.ProcessGuid].RunAsRoot = runAction.Privileged
 		}
 	}
 
@@ -211,6 +305,7 @@ func mergeDesiredActualLRPs(desiredLRPs []receptor.DesiredLRPResponse, actualLRP
 			Since:          actualLRP.Since,
 			PlacementError: actualLRP.PlacementError,
 			CrashCount:     actualLRP.CrashCount,
+			CrashReason:    actualLRP.CrashReason,
 		}
 
 		appMap[actualLRP.ProcessGuid].ActualInstances = append(appMap[actualLRP.ProcessGuid].ActualInstances, instanceInfo)
@@ -223,6 +318,26 @@ func mergeDesiredActualLRPs(desiredLRPs []receptor.DesiredLRPResponse, actualLRP
 	return appMap
 }
 
+// monitoredPort is the port passed to the healthcheck that ltc monitors apps
+// with, or 0 if the app is not monitored that way.
+func monitoredPort(monitor models.Action) uint16 {
+	runAction, ok := monitor.(*models.RunAction)
+	if !ok {
+		return 0
+	}
+
+	for i := 0; i+1 < len(runAction.Args); i++ {
+		if runAction.Args[i] == "-port" {
+			port, err := strconv.ParseUint(runAction.Args[i+1], 10, 16)
+			if err != nil {
+				return 0
+			}
+			return uint16(port)
+		}
+	}
+	return 0
+}
+
 func buildEnvVars(desiredLRPResponse receptor.DesiredLRPResponse) []EnvironmentVariable {
 	envVars := make([]EnvironmentVariable, 0)
 	for _, envVar := range desiredLRPResponse.EnvironmentVariables {
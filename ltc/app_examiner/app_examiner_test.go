@@ -10,6 +10,7 @@ import (
 	"github.com/cloudfoundry-incubator/lattice/ltc/route_helpers"
 	"github.com/cloudfoundry-incubator/receptor"
 	"github.com/cloudfoundry-incubator/receptor/fake_receptor"
+	"github.com/cloudfoundry-incubator/runtime-schema/models"
 )
 
 var _ = Describe("AppRunner", func() {
@@ -166,6 +167,58 @@ var _ = Describe("AppRunner", func() {
 			})
 		})
 
+		Context("receptor returns cells with capacity and desired lrps with resource requirements", func() {
+			BeforeEach(func() {
+				desiredLrps := []receptor.DesiredLRPResponse{
+					receptor.DesiredLRPResponse{ProcessGuid: "app-a", MemoryMB: 128, DiskMB: 512},
+					receptor.DesiredLRPResponse{ProcessGuid: "app-b", MemoryMB: 256, DiskMB: 1024},
+				}
+				fakeReceptorClient.DesiredLRPsReturns(desiredLrps, nil)
+
+				actualLrps := []receptor.ActualLRPResponse{
+					receptor.ActualLRPResponse{ProcessGuid: "app-b", Index: 1, CellID: "Cell-1", State: receptor.ActualLRPStateRunning},
+					receptor.ActualLRPResponse{ProcessGuid: "app-a", Index: 2, CellID: "Cell-1", State: receptor.ActualLRPStateClaimed},
+					receptor.ActualLRPResponse{ProcessGuid: "app-a", Index: 0, CellID: "Cell-1", State: receptor.ActualLRPStateRunning, Evacuating: true},
+					receptor.ActualLRPResponse{ProcessGuid: "app-a", Index: 1, CellID: "Cell-1", State: receptor.ActualLRPStateCrashed},
+				}
+				fakeReceptorClient.ActualLRPsReturns(actualLrps, nil)
+
+				cells := []receptor.CellResponse{
+					receptor.CellResponse{
+						CellID:   "Cell-1",
+						Stack:    "lucid64",
+						Zone:     "z1",
+						Capacity: receptor.CellCapacity{MemoryMB: 2048, DiskMB: 8192, Containers: 256},
+					},
+				}
+				fakeReceptorClient.CellsReturns(cells, nil)
+			})
+
+			It("returns the stack, zone, capacity, reservations and placed instances of each cell", func() {
+				cellList, err := appExaminer.ListCells()
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cellList).To(Equal([]app_examiner.CellInfo{
+					app_examiner.CellInfo{
+						CellID:              "Cell-1",
+						Stack:               "lucid64",
+						Zone:                "z1",
+						Capacity:            app_examiner.CellResources{MemoryMB: 2048, DiskMB: 8192, Containers: 256},
+						Reserved:            app_examiner.CellResources{MemoryMB: 512, DiskMB: 2048, Containers: 3},
+						RunningInstances:    2,
+						ClaimedInstances:    1,
+						EvacuatingInstances: 1,
+						Instances: []app_examiner.CellInstanceInfo{
+							app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 0, State: "RUNNING", Evacuating: true},
+							app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 1, State: "CRASHED"},
+							app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 2, State: "CLAIMED"},
+							app_examiner.CellInstanceInfo{ProcessGuid: "app-b", Index: 1, State: "RUNNING"},
+						},
+					},
+				}))
+			})
+		})
+
 		Context("receptor returns actual lrps, and some of their cells no longer exist", func() {
 			BeforeEach(func() {
 				actualLrps := []receptor.ActualLRPResponse{
@@ -231,6 +284,13 @@ var _ = Describe("AppRunner", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("returns errors from fetching the DesiredLRPs", func() {
+				fakeReceptorClient.DesiredLRPsReturns(nil, errors.New("Receptor is Walking."))
+				_, err := appExaminer.ListCells()
+
+				Expect(err).To(HaveOccurred())
+			})
+
 		})
 	})
 
@@ -267,6 +327,16 @@ var _ = Describe("AppRunner", func() {
 					LogGuid:      "9832-ur98j-idsckl",
 					LogSource:    "peekaboo-lawgz",
 					Annotation:   "best. game. ever.",
+					Action: &models.RunAction{
+						Path:       "/peekaboo",
+						Args:       []string{"-hide", "behind-couch"},
+						Dir:        "/peekaboo-home",
+						Privileged: true,
+					},
+					Monitor: &models.RunAction{
+						Path: "/tmp/healthcheck",
+						Args: []string{"-port", "8765"},
+					},
 				}
 
 				actualLRPsByProcessGuidResponse = []receptor.ActualLRPResponse{
@@ -306,6 +376,7 @@ var _ = Describe("AppRunner", func() {
 						Index:       3,
 						State:       "CRASHED",
 						CrashCount:  7,
+						CrashReason: "out of memory",
 					},
 				}
 			})
@@ -338,15 +409,21 @@ var _ = Describe("AppRunner", func() {
 							Value: "Bugs McGee",
 						},
 					},
-					StartTimeout: 5,
-					DiskMB:       256,
-					MemoryMB:     128,
-					CPUWeight:    77,
-					Ports:        []uint16{8765, 2300},
-					Routes:       route_helpers.AppRoutes{route_helpers.AppRoute{Hostnames: []string{"peekaboo-one.example.com", "peekaboo-too.example.com"}}},
-					LogGuid:      "9832-ur98j-idsckl",
-					LogSource:    "peekaboo-lawgz",
-					Annotation:   "best. game. ever.",
+					StartTimeout:  5,
+					DiskMB:        256,
+					MemoryMB:      128,
+					CPUWeight:     77,
+					Ports:         []uint16{8765, 2300},
+					Routes:        route_helpers.AppRoutes{route_helpers.AppRoute{Hostnames: []string{"peekaboo-one.example.com", "peekaboo-too.example.com"}}},
+					LogGuid:       "9832-ur98j-idsckl",
+					LogSource:     "peekaboo-lawgz",
+					Annotation:    "best. game. ever.",
+					RootFS:        "/var/root-fs",
+					StartCommand:  "/peekaboo",
+					StartArgs:     []string{"-hide", "behind-couch"},
+					WorkingDir:    "/peekaboo-home",
+					RunAsRoot:     true,
+					MonitoredPort: 8765,
 					ActualInstances: []app_examiner.InstanceInfo{
 						app_examiner.InstanceInfo{
 							InstanceGuid: "98s98a-xcvcx4-93isl",
@@ -384,10 +461,11 @@ var _ = Describe("AppRunner", func() {
 							PlacementError: "not enough resources. eek.",
 						},
 						app_examiner.InstanceInfo{
-							Index:      3,
-							State:      "CRASHED",
-							Ports:      []app_examiner.PortMapping{},
-							CrashCount: 7,
+							Index:       3,
+							State:       "CRASHED",
+							Ports:       []app_examiner.PortMapping{},
+							CrashCount:  7,
+							CrashReason: "out of memory",
 						},
 					},
 				}))
@@ -448,10 +526,11 @@ var _ = Describe("AppRunner", func() {
 								PlacementError: "not enough resources. eek.",
 							},
 							app_examiner.InstanceInfo{
-								Index:      3,
-								State:      "CRASHED",
-								Ports:      []app_examiner.PortMapping{},
-								CrashCount: 7,
+								Index:       3,
+								State:       "CRASHED",
+								Ports:       []app_examiner.PortMapping{},
+								CrashCount:  7,
+								CrashReason: "out of memory",
 							},
 						},
 					}))
@@ -466,6 +545,7 @@ var _ = Describe("AppRunner", func() {
 				result, err := appExaminer.AppStatus("peekaboo-app")
 
 				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(app_examiner.AppNotFoundError("peekaboo-app")))
 				Expect(err.Error()).To(Equal(app_examiner.AppNotFoundErrorMessage))
 				Expect(fakeReceptorClient.GetDesiredLRPCallCount()).To(Equal(1))
 				Expect(fakeReceptorClient.ActualLRPsByProcessGuidCallCount()).To(Equal(1))
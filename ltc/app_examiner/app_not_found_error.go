@@ -0,0 +1,11 @@
+package app_examiner
+
+type AppNotFoundError string
+
+func newAppNotFoundError(appName string) AppNotFoundError {
+	return AppNotFoundError(appName)
+}
+
+func (appName AppNotFoundError) Error() string {
+	return AppNotFoundErrorMessage
+}
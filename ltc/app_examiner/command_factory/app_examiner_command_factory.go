@@ -1,6 +1,7 @@
 package command_factory
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
@@ -11,16 +12,24 @@ import (
 
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/command_factory/presentation"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_code_helpers"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/cursor"
+	"github.com/cloudfoundry-incubator/receptor"
 	"github.com/codegangsta/cli"
 	"github.com/pivotal-golang/clock"
 )
 
 const TimestampDisplayLayout = "2006-01-02 15:04:05 (MST)"
 
+var jsonFlag = cli.BoolFlag{
+	Name:  "json, j",
+	Usage: "Outputs the result as JSON",
+}
+
 // IntSlice attaches the methods of sort.Interface to []uint16, sorting in increasing order.
 type UInt16Slice []uint16
 
@@ -47,12 +56,23 @@ func (factory *AppExaminerCommandFactory) MakeListAppCommand() cli.Command {
 		Usage:       "Lists applications running on lattice",
 		Description: "ltc list",
 		Action:      factory.listApps,
-		Flags:       []cli.Flag{},
+		Flags:       []cli.Flag{jsonFlag},
 	}
 
 	return listCommand
 }
 
+func (factory *AppExaminerCommandFactory) MakeCellsCommand() cli.Command {
+	return cli.Command{
+		Name:        "cells",
+		ShortName:   "ce",
+		Usage:       "Shows capacity, zone and placement details for the lattice cells",
+		Description: "ltc cells [--json]",
+		Action:      factory.listCells,
+		Flags:       []cli.Flag{jsonFlag},
+	}
+}
+
 func (factory *AppExaminerCommandFactory) MakeVisualizeCommand() cli.Command {
 
 	var visualizeFlags = []cli.Flag{
@@ -79,16 +99,20 @@ func (factory *AppExaminerCommandFactory) MakeStatusCommand() cli.Command {
 		Name:        "status",
 		ShortName:   "st",
 		Usage:       "Shows details about a running app on lattice",
-		Description: "ltc status APP_NAME",
+		Description: "ltc status APP_NAME [--json]",
 		Action:      factory.appStatus,
-		Flags:       []cli.Flag{},
+		Flags:       []cli.Flag{jsonFlag},
 	}
 }
 
 func (factory *AppExaminerCommandFactory) listApps(context *cli.Context) {
 	appList, err := factory.appExaminer.ListApps()
 	if err != nil {
-		factory.ui.Say("Error listing apps: " + err.Error())
+		factory.ui.SayError("Error listing apps: " + err.Error())
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
+		return
+	} else if context.Bool("json") {
+		factory.sayJSON(appList)
 		return
 	} else if len(appList) == 0 {
 		factory.ui.Say("No apps to display.")
@@ -114,6 +138,107 @@ func (factory *AppExaminerCommandFactory) listApps(context *cli.Context) {
 	w.Flush()
 }
 
+func (factory *AppExaminerCommandFactory) listCells(context *cli.Context) {
+	cellList, err := factory.appExaminer.ListCells()
+	if err != nil {
+		factory.ui.SayError("Error listing cells: " + err.Error())
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
+		return
+	} else if context.Bool("json") {
+		factory.sayJSON(cellList)
+		return
+	} else if len(cellList) == 0 {
+		factory.ui.Say("No cells to display.")
+		return
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(factory.ui, 10+colors.ColorCodeLength, 8, 1, '\t', 0)
+
+	header := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s", colors.Bold("Cell ID"), colors.Bold("Zone"), colors.Bold("Stack"), colors.Bold("MemoryMB"), colors.Bold("DiskMB"), colors.Bold("Containers"), colors.Bold("Instances"))
+	fmt.Fprintln(w, header)
+
+	for _, cellInfo := range cellList {
+		instances := fmt.Sprintf("%d running, %d claimed", cellInfo.RunningInstances, cellInfo.ClaimedInstances)
+		if cellInfo.EvacuatingInstances > 0 {
+			instances += ", " + colors.Yellow(fmt.Sprintf("%d evacuating", cellInfo.EvacuatingInstances))
+		}
+		if cellInfo.Missing {
+			instances += " " + colors.Red("[MISSING]")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			colors.Bold(cellInfo.CellID),
+			colors.NoColor(cellInfo.Zone),
+			colors.NoColor(cellInfo.Stack),
+			colorUtilization(cellInfo.Reserved.MemoryMB, cellInfo.Capacity.MemoryMB),
+			colorUtilization(cellInfo.Reserved.DiskMB, cellInfo.Capacity.DiskMB),
+			colorUtilization(cellInfo.Reserved.Containers, cellInfo.Capacity.Containers),
+			instances,
+		)
+	}
+
+	w.Flush()
+
+	factory.ui.NewLine()
+	factory.ui.SayLine(colors.Bold("Placement"))
+	for _, cellInfo := range cellList {
+		factory.ui.SayLine(fmt.Sprintf("%s: %s", cellInfo.CellID, formatCellInstances(cellInfo.Instances)))
+	}
+}
+
+func formatCellInstances(instances []app_examiner.CellInstanceInfo) string {
+	if len(instances) == 0 {
+		return colors.Red("empty")
+	}
+
+	var processGuids []string
+	indexesByProcessGuid := make(map[string][]string)
+	for _, instance := range instances {
+		if _, ok := indexesByProcessGuid[instance.ProcessGuid]; !ok {
+			processGuids = append(processGuids, instance.ProcessGuid)
+		}
+
+		index := strconv.Itoa(instance.Index)
+		if instance.Evacuating {
+			index = colors.Yellow(index + "(evacuating)")
+		}
+		indexesByProcessGuid[instance.ProcessGuid] = append(indexesByProcessGuid[instance.ProcessGuid], index)
+	}
+
+	placements := make([]string, 0, len(processGuids))
+	for _, processGuid := range processGuids {
+		placements = append(placements, fmt.Sprintf("%s[%s]", processGuid, strings.Join(indexesByProcessGuid[processGuid], ",")))
+	}
+
+	return strings.Join(placements, " ")
+}
+
+func colorUtilization(reserved, total int) string {
+	utilization := fmt.Sprintf("%d/%d", reserved, total)
+	switch {
+	case total == 0:
+		return colors.NoColor(utilization)
+	case reserved >= total:
+		return colors.Red(utilization)
+	case reserved*100/total >= 80:
+		return colors.Yellow(utilization)
+	}
+
+	return colors.Green(utilization)
+}
+
+func (factory *AppExaminerCommandFactory) sayJSON(v interface{}) {
+	jsonBytes, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		factory.ui.SayError("Error encoding JSON: " + err.Error())
+		factory.exitHandler.Exit(exit_codes.GeneralError)
+		return
+	}
+
+	factory.ui.SayLine(string(jsonBytes))
+}
+
 func printHorizontalRule(w io.Writer, pattern string) {
 	header := strings.Repeat(pattern, 80) + "\n"
 	fmt.Fprintf(w, header)
@@ -122,6 +247,7 @@ func printHorizontalRule(w io.Writer, pattern string) {
 func (factory *AppExaminerCommandFactory) appStatus(context *cli.Context) {
 	if len(context.Args()) < 1 {
 		factory.ui.IncorrectUsage("App Name required")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
@@ -129,7 +255,13 @@ func (factory *AppExaminerCommandFactory) appStatus(context *cli.Context) {
 	appInfo, err := factory.appExaminer.AppStatus(appName)
 
 	if err != nil {
-		factory.ui.Say(err.Error())
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
+		return
+	}
+
+	if context.Bool("json") {
+		factory.sayJSON(appInfo)
 		return
 	}
 
@@ -151,7 +283,7 @@ func (factory *AppExaminerCommandFactory) appStatus(context *cli.Context) {
 	fmt.Fprintln(w, "")
 	printHorizontalRule(w, "=")
 
-	printInstanceInfo(w, headingPrefix, appInfo.ActualInstances)
+	printInstanceInfo(w, headingPrefix, appInfo.ActualInstances, factory.clock.Now())
 	w.Flush()
 }
 
@@ -211,7 +343,7 @@ func printAppRoutes(w io.Writer, appInfo app_examiner.AppInfo) {
 	}
 }
 
-func printInstanceInfo(w io.Writer, headingPrefix string, actualInstances []app_examiner.InstanceInfo) {
+func printInstanceInfo(w io.Writer, headingPrefix string, actualInstances []app_examiner.InstanceInfo, now time.Time) {
 	instanceBar := func(index, state string) {
 		fmt.Fprintf(w, "%sInstance %s  [%s]\n", headingPrefix, index, state)
 		printHorizontalRule(w, "-")
@@ -220,7 +352,7 @@ func printInstanceInfo(w io.Writer, headingPrefix string, actualInstances []app_
 	for _, instance := range actualInstances {
 		instanceBar(fmt.Sprint(instance.Index), presentation.ColorInstanceState(instance))
 
-		if instance.PlacementError == "" && instance.State != "CRASHED" {
+		if instance.PlacementError == "" && instance.State != string(receptor.ActualLRPStateCrashed) {
 			fmt.Fprintf(w, "%s\t%s\n", "InstanceGuid", instance.InstanceGuid)
 			fmt.Fprintf(w, "%s\t%s\n", "Cell ID", instance.CellID)
 			fmt.Fprintf(w, "%s\t%s\n", "Ip", instance.Ip)
@@ -233,10 +365,17 @@ func printInstanceInfo(w io.Writer, headingPrefix string, actualInstances []app_
 
 			fmt.Fprintf(w, "%s\t%s\n", "Since", fmt.Sprint(time.Unix(0, instance.Since).Format(TimestampDisplayLayout)))
 
-		} else if instance.State != "CRASHED" {
+		} else if instance.State != string(receptor.ActualLRPStateCrashed) {
 			fmt.Fprintf(w, "%s\t%s\n", "Placement Error", instance.PlacementError)
 		}
 		fmt.Fprintf(w, "%s \t%d \n", "Crash Count", instance.CrashCount)
+		if instance.CrashReason != "" {
+			fmt.Fprintf(w, "%s\t%s\n", "Crash Reason", colors.Red(instance.CrashReason))
+		}
+		if instance.State == string(receptor.ActualLRPStateCrashed) {
+			timeSinceCrash := now.Sub(time.Unix(0, instance.Since)) / time.Second * time.Second
+			fmt.Fprintf(w, "%s\t%s ago\n", "Last Crash", timeSinceCrash)
+		}
 		printHorizontalRule(w, "-")
 	}
 }
@@ -245,13 +384,17 @@ func (factory *AppExaminerCommandFactory) visualizeCells(context *cli.Context) {
 	rate := context.Duration("rate")
 
 	factory.ui.Say(colors.Bold("Distribution\n"))
-	linesWritten := factory.printDistribution()
+	linesWritten, err := factory.printDistribution()
+	if err != nil {
+		factory.exitVisualizing(err)
+		return
+	}
 
 	if rate == 0 {
 		return
 	}
 
-	closeChan := make(chan struct{})
+	closeChan := make(chan struct{}, 1)
 	factory.ui.Say(cursor.Hide())
 
 	factory.exitHandler.OnExit(func() {
@@ -265,22 +408,32 @@ func (factory *AppExaminerCommandFactory) visualizeCells(context *cli.Context) {
 			return
 		case <-factory.clock.NewTimer(rate).C():
 			factory.ui.Say(cursor.Up(linesWritten))
-			linesWritten = factory.printDistribution()
+			if linesWritten, err = factory.printDistribution(); err != nil {
+				// Keep refreshing, so that the distribution comes back once
+				// the cells can be listed again.
+				factory.ui.Say("Error visualizing: " + err.Error())
+				factory.ui.Say(cursor.ClearToEndOfLine())
+				factory.ui.NewLine()
+				factory.ui.Say(cursor.ClearToEndOfDisplay())
+				linesWritten = 1
+			}
 		}
 	}
 }
 
-func (factory *AppExaminerCommandFactory) printDistribution() int {
-	defer factory.ui.Say(cursor.ClearToEndOfDisplay())
+func (factory *AppExaminerCommandFactory) exitVisualizing(err error) {
+	factory.ui.SayError("Error visualizing: " + err.Error())
+	factory.exitHandler.Exit(exit_code_helpers.ForError(err))
+}
 
+func (factory *AppExaminerCommandFactory) printDistribution() (int, error) {
 	cells, err := factory.appExaminer.ListCells()
 	if err != nil {
-		factory.ui.Say("Error visualizing: " + err.Error())
-		factory.ui.Say(cursor.ClearToEndOfLine())
-		factory.ui.NewLine()
-		return 1
+		return 0, err
 	}
 
+	defer factory.ui.Say(cursor.ClearToEndOfDisplay())
+
 	for _, cell := range cells {
 		factory.ui.Say(cell.CellID)
 		if cell.Missing {
@@ -298,7 +451,7 @@ func (factory *AppExaminerCommandFactory) printDistribution() int {
 		factory.ui.NewLine()
 	}
 
-	return len(cells)
+	return len(cells), nil
 }
 
 func colorInstances(appInfo app_examiner.AppInfo) string {
@@ -1,6 +1,7 @@
 package command_factory_test
 
 import (
+	"encoding/json"
 	"errors"
 	"os"
 	"time"
@@ -37,7 +38,7 @@ var _ = Describe("CommandFactory", func() {
 	BeforeEach(func() {
 		appExaminer = &fake_app_examiner.FakeAppExaminer{}
 		outputBuffer = gbytes.NewBuffer()
-		terminalUI = terminal.NewUI(nil, outputBuffer, nil)
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
 		osSignalChan = make(chan os.Signal, 1)
 		clock = fakeclock.NewFakeClock(time.Now())
 		exitHandler = &fake_exit_handler.FakeExitHandler{}
@@ -93,6 +94,22 @@ var _ = Describe("CommandFactory", func() {
 
 		})
 
+		It("outputs the apps as JSON when --json is passed", func() {
+			listApps := []app_examiner.AppInfo{
+				app_examiner.AppInfo{ProcessGuid: "process1", DesiredInstances: 2, ActualRunningInstances: 1, DiskMB: 100, MemoryMB: 50},
+			}
+			appExaminer.ListAppsReturns(listApps, nil)
+
+			test_helpers.ExecuteCommandWithArgs(listAppsCommand, []string{"--json"})
+
+			var decodedApps []map[string]interface{}
+			Expect(json.Unmarshal(outputBuffer.Contents(), &decodedApps)).To(Succeed())
+			Expect(decodedApps).To(HaveLen(1))
+			Expect(decodedApps[0]["process_guid"]).To(Equal("process1"))
+			Expect(decodedApps[0]["desired_instances"]).To(BeEquivalentTo(2))
+			Expect(decodedApps[0]["actual_running_instances"]).To(BeEquivalentTo(1))
+		})
+
 		It("alerts the user if there are no apps", func() {
 			listApps := []app_examiner.AppInfo{}
 			appExaminer.ListAppsReturns(listApps, nil)
@@ -114,6 +131,114 @@ var _ = Describe("CommandFactory", func() {
 		})
 	})
 
+	Describe("CellsCommand", func() {
+		var cellsCommand cli.Command
+
+		BeforeEach(func() {
+			commandFactory := command_factory.NewAppExaminerCommandFactory(appExaminer, terminalUI, clock, exitHandler)
+			cellsCommand = commandFactory.MakeCellsCommand()
+		})
+
+		It("displays capacity, zone and placement details for each cell", func() {
+			listCells := []app_examiner.CellInfo{
+				app_examiner.CellInfo{
+					CellID:           "cell-1",
+					Stack:            "lucid64",
+					Zone:             "z1",
+					Capacity:         app_examiner.CellResources{MemoryMB: 1024, DiskMB: 4096, Containers: 100},
+					Reserved:         app_examiner.CellResources{MemoryMB: 256, DiskMB: 2048, Containers: 3},
+					RunningInstances: 2,
+					ClaimedInstances: 1,
+					Instances: []app_examiner.CellInstanceInfo{
+						app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 0, State: "RUNNING"},
+						app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 2, State: "CLAIMED"},
+						app_examiner.CellInstanceInfo{ProcessGuid: "app-b", Index: 1, State: "RUNNING"},
+					},
+				},
+				app_examiner.CellInfo{
+					CellID:              "cell-2",
+					Stack:               "lucid64",
+					Zone:                "z2",
+					Capacity:            app_examiner.CellResources{MemoryMB: 1024, DiskMB: 4096, Containers: 100},
+					Reserved:            app_examiner.CellResources{MemoryMB: 1024, DiskMB: 1024, Containers: 1},
+					RunningInstances:    1,
+					EvacuatingInstances: 1,
+					Instances: []app_examiner.CellInstanceInfo{
+						app_examiner.CellInstanceInfo{ProcessGuid: "app-c", Index: 4, State: "RUNNING", Evacuating: true},
+					},
+				},
+				app_examiner.CellInfo{CellID: "cell-3", Missing: true},
+			}
+			appExaminer.ListCellsReturns(listCells, nil)
+
+			test_helpers.ExecuteCommandWithArgs(cellsCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Cell ID")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Zone")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Stack")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("MemoryMB")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("DiskMB")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Containers")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Instances")))
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("cell-1")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.NoColor("z1")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.NoColor("lucid64")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("256/1024")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("2048/4096")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("3/100")))
+			Expect(outputBuffer).To(test_helpers.Say("2 running, 1 claimed"))
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("cell-2")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("1024/1024")))
+			Expect(outputBuffer).To(test_helpers.Say("1 running, 0 claimed, " + colors.Yellow("1 evacuating")))
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("cell-3")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("[MISSING]")))
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Placement")))
+			Expect(outputBuffer).To(test_helpers.Say("cell-1: app-a[0,2] app-b[1]\n"))
+			Expect(outputBuffer).To(test_helpers.Say("cell-2: app-c[" + colors.Yellow("4(evacuating)") + "]\n"))
+			Expect(outputBuffer).To(test_helpers.Say("cell-3: " + colors.Red("empty") + "\n"))
+		})
+
+		It("outputs the cells as JSON when --json is passed", func() {
+			listCells := []app_examiner.CellInfo{
+				app_examiner.CellInfo{
+					CellID:    "cell-1",
+					Zone:      "z1",
+					Capacity:  app_examiner.CellResources{MemoryMB: 1024},
+					Instances: []app_examiner.CellInstanceInfo{app_examiner.CellInstanceInfo{ProcessGuid: "app-a", Index: 3}},
+				},
+			}
+			appExaminer.ListCellsReturns(listCells, nil)
+
+			test_helpers.ExecuteCommandWithArgs(cellsCommand, []string{"--json"})
+
+			var decodedCells []app_examiner.CellInfo
+			Expect(json.Unmarshal(outputBuffer.Contents(), &decodedCells)).To(Succeed())
+			Expect(decodedCells).To(Equal(listCells))
+		})
+
+		It("alerts the user if there are no cells", func() {
+			appExaminer.ListCellsReturns([]app_examiner.CellInfo{}, nil)
+
+			test_helpers.ExecuteCommandWithArgs(cellsCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.Say("No cells to display."))
+		})
+
+		Context("when the app examiner returns an error", func() {
+			It("alerts the user fetching the cells returns an error", func() {
+				appExaminer.ListCellsReturns(nil, errors.New("The cells were lost"))
+
+				test_helpers.ExecuteCommandWithArgs(cellsCommand, []string{})
+
+				Expect(outputBuffer).To(test_helpers.Say("Error listing cells: The cells were lost"))
+			})
+		})
+	})
+
 	Describe("VisualizeCommand", func() {
 		var visualizeCommand cli.Command
 
@@ -146,6 +271,7 @@ var _ = Describe("CommandFactory", func() {
 				test_helpers.ExecuteCommandWithArgs(visualizeCommand, []string{})
 
 				Expect(outputBuffer).To(test_helpers.Say("Error visualizing: The list was lost"))
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
 			})
 		})
 
@@ -179,18 +305,31 @@ var _ = Describe("CommandFactory", func() {
 				Eventually(outputBuffer).Should(test_helpers.Say(cursor.ClearToEndOfDisplay()))
 			})
 
-			It("dynamically displays any errors", func() {
-				appExaminer.ListCellsReturns(nil, errors.New("Spilled the Paint"))
+			It("keeps refreshing when fetching the cells fails", func() {
+				appExaminer.ListCellsStub = func() ([]app_examiner.CellInfo, error) {
+					if appExaminer.ListCellsCallCount() == 2 {
+						return nil, errors.New("Spilled the Paint")
+					}
+					return []app_examiner.CellInfo{app_examiner.CellInfo{CellID: "cell-0", RunningInstances: 1}}, nil
+				}
 
 				closeChan = test_helpers.AsyncExecuteCommandWithArgs(visualizeCommand, []string{"-rate", "1s"})
 
-				Eventually(outputBuffer).Should(test_helpers.Say("Error visualizing: Spilled the Paint" + cursor.ClearToEndOfLine() + "\n"))
+				Eventually(outputBuffer).Should(test_helpers.Say(cursor.Hide()))
 
 				clock.IncrementBySeconds(1)
 
 				Eventually(outputBuffer).Should(test_helpers.Say(cursor.Up(1)))
-				Eventually(outputBuffer).Should(test_helpers.Say("Error visualizing: Spilled the Paint" + cursor.ClearToEndOfLine() + "\n"))
-				Eventually(outputBuffer).Should(test_helpers.Say(cursor.ClearToEndOfDisplay()))
+				Eventually(outputBuffer).Should(test_helpers.Say("Error visualizing: Spilled the Paint"))
+				Eventually(outputBuffer).Should(test_helpers.Say(cursor.ClearToEndOfLine()))
+				Eventually(clock.WatcherCount).Should(Equal(1))
+
+				clock.IncrementBySeconds(1)
+
+				Eventually(outputBuffer).Should(test_helpers.Say(cursor.Up(1)))
+				Eventually(outputBuffer).Should(test_helpers.Say("cell-0: " + colors.Green("•") + cursor.ClearToEndOfLine() + "\n"))
+				Consistently(closeChan).ShouldNot(BeClosed())
+				Expect(exitHandler.ExitCalledWith).To(BeEmpty())
 			})
 
 			It("Ensures the user's cursor is visible even if they interrupt ltc", func() {
@@ -391,6 +530,53 @@ var _ = Describe("CommandFactory", func() {
 			})
 		})
 
+		It("outputs the app status as JSON when --json is passed", func() {
+			appInfo := app_examiner.AppInfo{
+				ProcessGuid:      "jsony-app",
+				DesiredInstances: 1,
+				ActualInstances: []app_examiner.InstanceInfo{
+					app_examiner.InstanceInfo{Index: 0, State: "RUNNING", CellID: "cell-1"},
+				},
+			}
+			appExaminer.AppStatusReturns(appInfo, nil)
+
+			test_helpers.ExecuteCommandWithArgs(statusCommand, []string{"jsony-app", "--json"})
+
+			var decodedApp app_examiner.AppInfo
+			Expect(json.Unmarshal(outputBuffer.Contents(), &decodedApp)).To(Succeed())
+			Expect(decodedApp.ProcessGuid).To(Equal("jsony-app"))
+			Expect(decodedApp.ActualInstances).To(HaveLen(1))
+			Expect(decodedApp.ActualInstances[0].CellID).To(Equal("cell-1"))
+		})
+
+		Context("when an instance has crashed", func() {
+			It("displays the crash reason and the time since the last crash", func() {
+				appExaminer.AppStatusReturns(
+					app_examiner.AppInfo{
+						ActualInstances: []app_examiner.InstanceInfo{
+							app_examiner.InstanceInfo{
+								Index:       2,
+								State:       "CRASHED",
+								CrashCount:  4,
+								CrashReason: "Exited with status 137",
+								Since:       clock.Now().Add(-200 * time.Second).UnixNano(),
+							},
+						},
+					}, nil)
+
+				test_helpers.ExecuteCommandWithArgs(statusCommand, []string{"crashy-app"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Instance 2"))
+				Expect(outputBuffer).To(test_helpers.Say("CRASHED"))
+				Expect(outputBuffer).To(test_helpers.Say("Crash Count"))
+				Expect(outputBuffer).To(test_helpers.Say("4"))
+				Expect(outputBuffer).To(test_helpers.Say("Crash Reason"))
+				Expect(outputBuffer).To(test_helpers.Say(colors.Red("Exited with status 137")))
+				Expect(outputBuffer).To(test_helpers.Say("Last Crash"))
+				Expect(outputBuffer).To(test_helpers.Say("3m20s ago"))
+			})
+		})
+
 		Context("When no appName is specified", func() {
 			It("Prints usage information", func() {
 				test_helpers.ExecuteCommandWithArgs(statusCommand, []string{})
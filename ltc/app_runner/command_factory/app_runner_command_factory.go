@@ -8,13 +8,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_metadata_fetcher"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_code_helpers"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry-incubator/receptor"
 	"github.com/codegangsta/cli"
 	"github.com/pivotal-golang/clock"
 	"github.com/pivotal-golang/lager"
@@ -24,10 +27,28 @@ const (
 	InvalidPortErrorMessage          = "Invalid port specified. Ports must be a comma-delimited list of integers between 0-65535."
 	MalformedRouteErrorMessage       = "Malformed route. Routes must be of the format route:port"
 	MustSetMonitoredPortErrorMessage = "Must set monitored-port when specifying multiple exposed ports unless --no-monitor is set."
+
+	defaultPlacementErrorReason = "insufficient resources"
+	crashLoopThreshold          = 3
+
+	waitForRunning   = "running"
+	waitForRemoved   = "removed"
+	waitForInstances = "instances="
 )
 
+var noWaitFlag = cli.BoolFlag{
+	Name:  "no-wait",
+	Usage: "Returns as soon as the request is accepted instead of waiting for the app to converge",
+}
+
+var timeoutFlag = cli.DurationFlag{
+	Name:  "timeout",
+	Usage: "How long to wait for the app to converge (e.g., 5m). Defaults to LATTICE_CLI_TIMEOUT or 1m",
+}
+
 type AppRunnerCommandFactory struct {
 	appRunner             docker_app_runner.AppRunner
+	appExaminer           app_examiner.AppExaminer
 	ui                    terminal.UI
 	dockerMetadataFetcher docker_metadata_fetcher.DockerMetadataFetcher
 	timeout               time.Duration
@@ -40,6 +61,7 @@ type AppRunnerCommandFactory struct {
 
 type AppRunnerCommandFactoryConfig struct {
 	AppRunner             docker_app_runner.AppRunner
+	AppExaminer           app_examiner.AppExaminer
 	UI                    terminal.UI
 	DockerMetadataFetcher docker_metadata_fetcher.DockerMetadataFetcher
 	Timeout               time.Duration
@@ -53,8 +75,9 @@ type AppRunnerCommandFactoryConfig struct {
 
 func NewAppRunnerCommandFactory(config AppRunnerCommandFactoryConfig) *AppRunnerCommandFactory {
 	return &AppRunnerCommandFactory{
-		appRunner: config.AppRunner,
-		ui:        config.UI,
+		appRunner:   config.AppRunner,
+		appExaminer: config.AppExaminer,
+		ui:          config.UI,
 		dockerMetadataFetcher: config.DockerMetadataFetcher,
 		timeout:               config.Timeout,
 		domain:                config.Domain,
@@ -119,6 +142,8 @@ func (factory *AppRunnerCommandFactory) MakeCreateAppCommand() cli.Command {
 			Name:  "no-monitor",
 			Usage: "Disables healthchecking for the app.",
 		},
+		noWaitFlag,
+		timeoutFlag,
 	}
 
 	var createAppCommand = cli.Command{
@@ -156,8 +181,9 @@ func (factory *AppRunnerCommandFactory) MakeScaleAppCommand() cli.Command {
 		Name:        "scale",
 		ShortName:   "sc",
 		Usage:       "Scales a docker app on lattice",
-		Description: "ltc scale APP_NAME NUM_INSTANCES",
+		Description: "ltc scale APP_NAME NUM_INSTANCES [--no-wait] [--timeout=DURATION]",
 		Action:      factory.scaleApp,
+		Flags:       []cli.Flag{noWaitFlag, timeoutFlag},
 	}
 
 	return scaleAppCommand
@@ -179,14 +205,40 @@ func (factory *AppRunnerCommandFactory) MakeRemoveAppCommand() cli.Command {
 	var removeAppCommand = cli.Command{
 		Name:        "remove",
 		ShortName:   "rm",
-		Description: "ltc remove APP_NAME",
+		Description: "ltc remove APP_NAME [--no-wait] [--timeout=DURATION]",
 		Usage:       "Stops and removes a docker app from lattice",
 		Action:      factory.removeApp,
+		Flags:       []cli.Flag{noWaitFlag, timeoutFlag},
 	}
 
 	return removeAppCommand
 }
 
+func (factory *AppRunnerCommandFactory) MakeWaitCommand() cli.Command {
+	var waitFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "for, f",
+			Usage: "What to wait for: running, removed or instances=N",
+			Value: waitForRunning,
+		},
+		timeoutFlag,
+	}
+
+	var waitCommand = cli.Command{
+		Name:      "wait",
+		ShortName: "wa",
+		Usage:     "Waits for an app to converge after create, scale or remove --no-wait",
+		Description: `ltc wait APP_NAME [--for=running|removed|instances=N] [--timeout=DURATION]
+
+   Exits with a non-zero status if the app does not converge before the timeout,
+   cannot be placed, or crashes repeatedly.`,
+		Action: factory.waitForApp,
+		Flags:  waitFlags,
+	}
+
+	return waitCommand
+}
+
 func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 	workingDirFlag := context.String("working-dir")
 	envVarsFlag := context.StringSlice("env")
@@ -198,6 +250,7 @@ func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 	monitoredPortFlag := context.Int("monitored-port")
 	routesFlag := context.String("routes")
 	noMonitorFlag := context.Bool("no-monitor")
+	noWaitFlag := context.Bool("no-wait")
 	name := context.Args().Get(0)
 	dockerImage := context.Args().Get(1)
 	terminator := context.Args().Get(2)
@@ -208,26 +261,36 @@ func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 	switch {
 	case len(context.Args()) < 2:
 		factory.ui.IncorrectUsage("APP_NAME and DOCKER_IMAGE are required")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	case startCommand != "" && terminator != "--":
 		factory.ui.IncorrectUsage("'--' Required before start command")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	case len(context.Args()) > 4:
 		appArgs = context.Args()[4:]
 	case cpuWeightFlag < 1 || cpuWeightFlag > 100:
 		factory.ui.IncorrectUsage("Invalid CPU Weight")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	timeout, ok := factory.timeoutFromFlag(context)
+	if !ok {
 		return
 	}
 
 	imageMetadata, err := factory.dockerMetadataFetcher.FetchMetadata(dockerImage)
 	if err != nil {
-		factory.ui.Say(fmt.Sprintf("Error fetching image metadata: %s", err))
+		factory.ui.SayError(fmt.Sprintf("Error fetching image metadata: %s", err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
 		return
 	}
 
 	portConfig, err := factory.getPortConfigFromArgs(portsFlag, monitoredPortFlag, noMonitorFlag, imageMetadata)
 	if err != nil {
-		factory.ui.Say(err.Error())
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
@@ -250,7 +313,8 @@ func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 
 	if startCommand == "" {
 		if len(imageMetadata.StartCommand) == 0 {
-			factory.ui.SayLine("Unable to determine start command from image metadata.")
+			factory.ui.SayError("Unable to determine start command from image metadata.")
+			factory.exitHandler.Exit(exit_codes.GeneralError)
 			return
 		}
 
@@ -265,7 +329,8 @@ func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 
 	routeOverrides, err := parseRouteOverrides(routesFlag)
 	if err != nil {
-		factory.ui.Say(err.Error())
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
@@ -286,19 +351,22 @@ func (factory *AppRunnerCommandFactory) createApp(context *cli.Context) {
 		RouteOverrides:       routeOverrides,
 	})
 	if err != nil {
-		factory.ui.Say(fmt.Sprintf("Error Creating App: %s", err))
+		factory.ui.SayError(fmt.Sprintf("Error Creating App: %s", err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
 		return
 	}
 
 	factory.ui.Say("Creating App: " + name + "\n")
 
-	go factory.tailedLogsOutputter.OutputTailedLogs(name)
-	defer factory.tailedLogsOutputter.StopOutputting()
-
-	ok := factory.pollUntilAllInstancesRunning(name, instancesFlag, "start")
+	if noWaitFlag {
+		factory.ui.SayLine(fmt.Sprintf("Not waiting for %s to start. Run 'ltc wait %s' to wait for it.", name, name))
+	} else {
+		go factory.tailedLogsOutputter.OutputTailedLogs(name)
+		defer factory.tailedLogsOutputter.StopOutputting()
 
-	if ok {
-		factory.ui.Say(colors.Green(name + " is now running.\n"))
+		if factory.pollUntilAllInstancesRunning(name, instancesFlag, timeout, "start") {
+			factory.ui.Say(colors.Green(name + " is now running.\n"))
+		}
 	}
 
 	if routeOverrides != nil {
@@ -316,16 +384,23 @@ func (factory *AppRunnerCommandFactory) scaleApp(c *cli.Context) {
 
 	if appName == "" || instancesArg == "" {
 		factory.ui.IncorrectUsage("Please enter 'ltc scale APP_NAME NUMBER_OF_INSTANCES'")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
 	instances, err := strconv.Atoi(instancesArg)
 	if err != nil {
 		factory.ui.IncorrectUsage("Number of Instances must be an integer")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
-	factory.setAppInstances(appName, instances)
+	timeout, ok := factory.timeoutFromFlag(c)
+	if !ok {
+		return
+	}
+
+	factory.setAppInstances(c, appName, instances, timeout)
 }
 
 func (factory *AppRunnerCommandFactory) updateAppRoutes(c *cli.Context) {
@@ -334,92 +409,258 @@ func (factory *AppRunnerCommandFactory) updateAppRoutes(c *cli.Context) {
 
 	if appName == "" || userDefinedRoutes == "" {
 		factory.ui.IncorrectUsage("Please enter 'ltc update-routes APP_NAME NEW_ROUTES'")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
 	desiredRoutes, err := parseRouteOverrides(userDefinedRoutes)
 	if err != nil {
-		factory.ui.Say(err.Error())
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
 	err = factory.appRunner.UpdateAppRoutes(appName, desiredRoutes)
 	if err != nil {
-		factory.ui.Say(fmt.Sprintf("Error updating routes: %s", err))
+		factory.ui.SayError(fmt.Sprintf("Error updating routes: %s", err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
 		return
 	}
 
 	factory.ui.Say(fmt.Sprintf("Updating %s routes. You can check this app's current routes by running 'ltc status %s'", appName, appName))
 }
 
-func (factory *AppRunnerCommandFactory) setAppInstances(appName string, instances int) {
+func (factory *AppRunnerCommandFactory) setAppInstances(c *cli.Context, appName string, instances int, timeout time.Duration) {
 	err := factory.appRunner.ScaleApp(appName, instances)
 
 	if err != nil {
-		factory.ui.Say(fmt.Sprintf("Error Scaling App to %d instances: %s", instances, err))
+		factory.ui.SayError(fmt.Sprintf("Error Scaling App to %d instances: %s", instances, err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
 		return
 	}
 
 	factory.ui.Say(fmt.Sprintf("Scaling %s to %d instances \n", appName, instances))
 
-	ok := factory.pollUntilAllInstancesRunning(appName, instances, "scale")
+	if c.Bool("no-wait") {
+		factory.ui.SayLine(fmt.Sprintf("Not waiting for %s to scale. Run 'ltc wait %s --for=instances=%d' to wait for it.", appName, appName, instances))
+		return
+	}
 
-	if ok {
+	if factory.pollUntilAllInstancesRunning(appName, instances, timeout, "scale") {
 		factory.ui.Say(colors.Green("App Scaled Successfully"))
 	}
 }
 
-func (factory *AppRunnerCommandFactory) pollUntilAllInstancesRunning(appName string, instances int, action string) bool {
+// pollUntilAllInstancesRunning exits on placement errors, crash loops and
+// timeouts, returning whether all instances came up.
+func (factory *AppRunnerCommandFactory) pollUntilAllInstancesRunning(appName string, instances int, timeout time.Duration, action string) bool {
 	placementErrorOccurred := false
+	crashLoopOccurred := false
+	var initialCrashCounts map[int]int
+
 	ok := factory.pollUntilSuccess(func() bool {
 		numberOfRunningInstances, placementError, _ := factory.appRunner.RunningAppInstancesInfo(appName)
 		if placementError {
-			factory.ui.Say(colors.Red("Error, could not place all instances: insufficient resources. Try requesting fewer instances or reducing the requested memory or disk capacity."))
+			factory.ui.SayError(colors.Red(fmt.Sprintf("Error, could not place all instances: %s. Try requesting fewer instances or reducing the requested memory or disk capacity.", factory.placementErrorReason(appName))))
 			placementErrorOccurred = true
 			return true
 		}
-		return numberOfRunningInstances == instances
-	}, true)
+		if numberOfRunningInstances == instances {
+			return true
+		}
+
+		appInfo, err := factory.appExaminer.AppStatus(appName)
+		if err != nil {
+			return false
+		}
+		if initialCrashCounts == nil {
+			initialCrashCounts = crashCountsByIndex(appInfo)
+		}
+		if instance, crashLooping := crashLoopingInstance(appInfo, initialCrashCounts); crashLooping {
+			factory.ui.SayError(colors.Red(crashLoopMessage(appName, instance)))
+			crashLoopOccurred = true
+			return true
+		}
+		return false
+	}, timeout, true)
 
 	if placementErrorOccurred {
 		factory.exitHandler.Exit(exit_codes.PlacementError)
 		return false
-	} else if !ok {
-		factory.ui.SayLine(colors.Red(appName + " took too long to " + action + "."))
+	} else if crashLoopOccurred {
+		factory.exitHandler.Exit(exit_codes.CrashLoop)
+		return false
+	} else if !ok && !factory.cancelled() {
+		factory.ui.SayError(colors.Red(appName + " took too long to " + action + "."))
+		factory.exitHandler.Exit(exit_codes.Timeout)
 	}
 	return ok
+}
+
+func (factory *AppRunnerCommandFactory) placementErrorReason(appName string) string {
+	appInfo, err := factory.appExaminer.AppStatus(appName)
+	if err != nil {
+		return defaultPlacementErrorReason
+	}
+
+	for _, instance := range appInfo.ActualInstances {
+		if instance.PlacementError != "" {
+			return strings.TrimSuffix(instance.PlacementError, ".")
+		}
+	}
+
+	return defaultPlacementErrorReason
+}
+
+func crashCountsByIndex(appInfo app_examiner.AppInfo) map[int]int {
+	crashCounts := make(map[int]int)
+	for _, instance := range appInfo.ActualInstances {
+		crashCounts[instance.Index] = instance.CrashCount
+	}
+	return crashCounts
+}
+
+func crashLoopingInstance(appInfo app_examiner.AppInfo, initialCrashCounts map[int]int) (app_examiner.InstanceInfo, bool) {
+	for _, instance := range appInfo.ActualInstances {
+		if instance.State == string(receptor.ActualLRPStateRunning) {
+			continue
+		}
+		if instance.CrashCount-initialCrashCounts[instance.Index] >= crashLoopThreshold {
+			return instance, true
+		}
+	}
+	return app_examiner.InstanceInfo{}, false
+}
 
+func crashLoopMessage(appName string, instance app_examiner.InstanceInfo) string {
+	message := fmt.Sprintf("Error, %s instance %d has crashed %d times", appName, instance.Index, instance.CrashCount)
+	if instance.CrashReason != "" {
+		message += ": " + instance.CrashReason
+	}
+	return message
 }
 
 func (factory *AppRunnerCommandFactory) removeApp(c *cli.Context) {
 	appName := c.Args().First()
 	if appName == "" {
 		factory.ui.IncorrectUsage("App Name required")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	timeout, ok := factory.timeoutFromFlag(c)
+	if !ok {
 		return
 	}
 
 	err := factory.appRunner.RemoveApp(appName)
 	if err != nil {
-		factory.ui.Say(fmt.Sprintf("Error Stopping App: %s", err))
+		factory.ui.SayError(fmt.Sprintf("Error Stopping App: %s", err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
 		return
 	}
 
 	factory.ui.Say(fmt.Sprintf("Removing %s", appName))
-	ok := factory.pollUntilSuccess(func() bool {
+
+	if c.Bool("no-wait") {
+		factory.ui.NewLine()
+		factory.ui.SayLine(fmt.Sprintf("Not waiting for %s to be removed. Run 'ltc wait %s --for=removed' to wait for it.", appName, appName))
+		return
+	}
+
+	if factory.pollUntilRemoved(appName, timeout) {
+		factory.ui.Say(colors.Green("Successfully Removed " + appName + "."))
+	} else if !factory.cancelled() {
+		factory.ui.SayError(colors.Red(fmt.Sprintf("Failed to remove %s.", appName)))
+		factory.exitHandler.Exit(exit_codes.Timeout)
+	}
+}
+
+func (factory *AppRunnerCommandFactory) pollUntilRemoved(appName string, timeout time.Duration) bool {
+	return factory.pollUntilSuccess(func() bool {
 		appExists, err := factory.appRunner.AppExists(appName)
 		return err == nil && !appExists
-	}, true)
+	}, timeout, true)
+}
 
-	if ok {
-		factory.ui.Say(colors.Green("Successfully Removed " + appName + "."))
-	} else {
-		factory.ui.Say(colors.Red(fmt.Sprintf("Failed to remove %s.", appName)))
+func (factory *AppRunnerCommandFactory) waitForApp(c *cli.Context) {
+	appName := c.Args().First()
+	forFlag := c.String("for")
+
+	if appName == "" {
+		factory.ui.IncorrectUsage("App Name required")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	timeout, ok := factory.timeoutFromFlag(c)
+	if !ok {
+		return
+	}
+
+	if forFlag == waitForRemoved {
+		factory.ui.Say(fmt.Sprintf("Waiting for %s to be removed", appName))
+		if !factory.pollUntilRemoved(appName, timeout) {
+			if factory.cancelled() {
+				return
+			}
+			factory.ui.SayError(colors.Red(appName + " took too long to be removed."))
+			factory.exitHandler.Exit(exit_codes.Timeout)
+			return
+		}
+		factory.ui.SayLine(colors.Green(appName + " has been removed."))
+		return
+	}
+
+	if forFlag != waitForRunning && !strings.HasPrefix(forFlag, waitForInstances) {
+		factory.ui.IncorrectUsage("--for must be one of running, removed or instances=N")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	appInfo, err := factory.appExaminer.AppStatus(appName)
+	if err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error waiting for %s: %s", appName, err))
+		factory.exitHandler.Exit(exit_code_helpers.ForError(err))
+		return
+	}
+
+	instances, action := appInfo.DesiredInstances, "start"
+	if forFlag != waitForRunning {
+		instances, err = strconv.Atoi(strings.TrimPrefix(forFlag, waitForInstances))
+		if err != nil || instances < 0 {
+			factory.ui.IncorrectUsage("Number of Instances must be a non-negative integer")
+			factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+			return
+		}
+		action = "scale"
 	}
+
+	factory.ui.Say(fmt.Sprintf("Waiting for %d instances of %s to be running", instances, appName))
+	if factory.pollUntilAllInstancesRunning(appName, instances, timeout, action) {
+		factory.ui.SayLine(colors.Green(fmt.Sprintf("%s has %d running instances.", appName, instances)))
+	}
+}
+
+// timeoutFromFlag returns the --timeout given to the command, falling back to
+// the LATTICE_CLI_TIMEOUT default.
+func (factory *AppRunnerCommandFactory) timeoutFromFlag(c *cli.Context) (time.Duration, bool) {
+	if !c.IsSet("timeout") {
+		return factory.timeout, true
+	}
+
+	timeout := c.Duration("timeout")
+	if timeout <= 0 {
+		factory.ui.IncorrectUsage("Timeout must be a positive duration (e.g., 5m)")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return 0, false
+	}
+	return timeout, true
 }
 
-func (factory *AppRunnerCommandFactory) pollUntilSuccess(pollingFunc func() bool, outputProgress bool) (ok bool) {
+func (factory *AppRunnerCommandFactory) pollUntilSuccess(pollingFunc func() bool, timeout time.Duration, outputProgress bool) (ok bool) {
 	startingTime := factory.clock.Now()
-	for startingTime.Add(factory.timeout).After(factory.clock.Now()) {
+	for startingTime.Add(timeout).After(factory.clock.Now()) {
 		if result := pollingFunc(); result {
 			factory.ui.NewLine()
 			return true
@@ -427,12 +668,28 @@ func (factory *AppRunnerCommandFactory) pollUntilSuccess(pollingFunc func() bool
 			factory.ui.Say(".")
 		}
 
-		factory.clock.Sleep(1 * time.Second)
+		select {
+		case <-factory.exitHandler.Cancelled():
+			factory.ui.NewLine()
+			return false
+		case <-factory.clock.NewTimer(1 * time.Second).C():
+		}
 	}
 	factory.ui.NewLine()
 	return false
 }
 
+// cancelled reports whether ltc is exiting, in which case polling stopped
+// early and should not be reported as a timeout.
+func (factory *AppRunnerCommandFactory) cancelled() bool {
+	select {
+	case <-factory.exitHandler.Cancelled():
+		return true
+	default:
+		return false
+	}
+}
+
 func (factory *AppRunnerCommandFactory) urlForApp(name string) string {
 	return fmt.Sprintf("http://%s.%s\n", name, factory.domain)
 }
@@ -2,12 +2,15 @@ package command_factory_test
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/command_factory"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
@@ -29,6 +32,7 @@ var _ = Describe("CommandFactory", func() {
 
 	var (
 		appRunner                     *fake_app_runner.FakeAppRunner
+		appExaminer                   *fake_app_examiner.FakeAppExaminer
 		outputBuffer                  *gbytes.Buffer
 		terminalUI                    terminal.UI
 		timeout                       time.Duration = 10 * time.Second
@@ -43,8 +47,9 @@ var _ = Describe("CommandFactory", func() {
 
 	BeforeEach(func() {
 		appRunner = &fake_app_runner.FakeAppRunner{}
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
 		outputBuffer = gbytes.NewBuffer()
-		terminalUI = terminal.NewUI(nil, outputBuffer, nil)
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
 		dockerMetadataFetcher = &fake_docker_metadata_fetcher.FakeDockerMetadataFetcher{}
 		clock = fakeclock.NewFakeClock(time.Now())
 		logger = lager.NewLogger("ltc-test")
@@ -58,8 +63,9 @@ var _ = Describe("CommandFactory", func() {
 		BeforeEach(func() {
 			env := []string{"SHELL=/bin/bash", "COLOR=Blue"}
 			appRunnerCommandFactoryConfig = command_factory.AppRunnerCommandFactoryConfig{
-				AppRunner: appRunner,
-				UI:        terminalUI,
+				AppRunner:   appRunner,
+				AppExaminer: appExaminer,
+				UI:          terminalUI,
 				DockerMetadataFetcher: dockerMetadataFetcher,
 				Timeout:               timeout,
 				Domain:                domain,
@@ -240,6 +246,22 @@ var _ = Describe("CommandFactory", func() {
 
 					Expect(appRunner.CreateDockerAppCallCount()).To(Equal(0))
 					Expect(outputBuffer).To(test_helpers.Say("Error fetching image metadata: Docker Says No."))
+					Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
+				})
+
+				It("exits with the registry error code when the registry lookup fails", func() {
+					args := []string{
+						"cool-web-app",
+						"superfun/app",
+						"--",
+						"/start-me-please",
+					}
+					dockerMetadataFetcher.FetchMetadataReturns(nil, docker_app_runner.NewRegistryError("superfun/app", errors.New("Unknown tag: superfun/app:latest")))
+
+					test_helpers.ExecuteCommandWithArgs(createCommand, args)
+
+					Expect(outputBuffer).To(test_helpers.Say("Error fetching image metadata: Unknown tag: superfun/app:latest"))
+					Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.RegistryError}))
 				})
 			})
 		})
@@ -527,6 +549,7 @@ var _ = Describe("CommandFactory", func() {
 					test_helpers.ExecuteCommandWithArgs(createCommand, args)
 
 					Expect(outputBuffer).To(test_helpers.Say("Unable to determine start command from image metadata.\n"))
+					Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
 					Expect(appRunner.CreateDockerAppCallCount()).To(BeZero())
 				})
 			})
@@ -594,6 +617,53 @@ var _ = Describe("CommandFactory", func() {
 
 				Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to start.")))
 				Expect(outputBuffer).To(test_helpers.SayNewLine())
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
+			})
+
+			It("polls for as long as the --timeout flag says", func() {
+				args := []string{
+					"--timeout=30s",
+					"cool-web-app",
+					"superfun/app",
+					"--",
+					"/start-me-please",
+				}
+				dockerMetadataFetcher.FetchMetadataReturns(&docker_metadata_fetcher.ImageMetadata{}, nil)
+				appRunner.RunningAppInstancesInfoReturns(0, false, nil)
+
+				commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(createCommand, args)
+
+				Eventually(outputBuffer).Should(test_helpers.Say("Creating App: cool-web-app"))
+
+				clock.IncrementBySeconds(10)
+				Consistently(commandFinishChan).ShouldNot(BeClosed())
+
+				clock.IncrementBySeconds(20)
+
+				Eventually(commandFinishChan).Should(BeClosed())
+				Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to start.")))
+			})
+
+			It("stops polling without reporting a timeout when ltc is exiting", func() {
+				args := []string{
+					"cool-web-app",
+					"superfun/app",
+					"--",
+					"/start-me-please",
+				}
+				dockerMetadataFetcher.FetchMetadataReturns(&docker_metadata_fetcher.ImageMetadata{}, nil)
+				appRunner.RunningAppInstancesInfoReturns(0, false, nil)
+
+				commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(createCommand, args)
+
+				Eventually(outputBuffer).Should(test_helpers.Say("Creating App: cool-web-app"))
+				Consistently(commandFinishChan).ShouldNot(BeClosed())
+
+				fakeExitHandler.Cancel()
+
+				Eventually(commandFinishChan).Should(BeClosed())
+				Expect(outputBuffer).ToNot(test_helpers.Say("took too long"))
+				Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
 			})
 
 			Context("when there is a placement error when polling for the app to start", func() {
@@ -637,6 +707,93 @@ var _ = Describe("CommandFactory", func() {
 			})
 		})
 
+		Context("when the placement error reported by the receptor has details", func() {
+			It("includes the placement error in the failure message", func() {
+				args := []string{
+					"cool-web-app",
+					"superfun/app",
+					"--",
+					"/start-me-please",
+				}
+
+				dockerMetadataFetcher.FetchMetadataReturns(&docker_metadata_fetcher.ImageMetadata{}, nil)
+				appRunner.RunningAppInstancesInfoReturns(0, true, nil)
+				appExaminer.AppStatusReturns(app_examiner.AppInfo{
+					ActualInstances: []app_examiner.InstanceInfo{
+						app_examiner.InstanceInfo{Index: 0, State: "UNCLAIMED", PlacementError: "found no compatible cell."},
+					},
+				}, nil)
+
+				test_helpers.ExecuteCommandWithArgs(createCommand, args)
+
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.PlacementError}))
+				Expect(outputBuffer).To(test_helpers.Say(colors.Red("Error, could not place all instances: found no compatible cell. Try requesting fewer instances or reducing the requested memory or disk capacity.")))
+			})
+		})
+
+		Context("when an instance crashes repeatedly while polling for the app to start", func() {
+			It("prints the crash reason and exits early with the crash loop exit code", func() {
+				args := []string{
+					"--instances=2",
+					"cool-web-app",
+					"superfun/app",
+					"--",
+					"/start-me-please",
+				}
+
+				dockerMetadataFetcher.FetchMetadataReturns(&docker_metadata_fetcher.ImageMetadata{}, nil)
+				appRunner.RunningAppInstancesInfoReturns(1, false, nil)
+
+				var appStatusLock sync.Mutex
+				appStatus := app_examiner.AppInfo{
+					ActualInstances: []app_examiner.InstanceInfo{
+						app_examiner.InstanceInfo{Index: 0, State: "RUNNING"},
+						app_examiner.InstanceInfo{Index: 1, State: "CLAIMED"},
+					},
+				}
+				appExaminer.AppStatusStub = func(string) (app_examiner.AppInfo, error) {
+					appStatusLock.Lock()
+					defer appStatusLock.Unlock()
+					return appStatus, nil
+				}
+				setAppStatus := func(appInfo app_examiner.AppInfo) {
+					appStatusLock.Lock()
+					defer appStatusLock.Unlock()
+					appStatus = appInfo
+				}
+
+				commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(createCommand, args)
+
+				Eventually(outputBuffer).Should(test_helpers.Say("Creating App: cool-web-app"))
+				Eventually(appExaminer.AppStatusCallCount).Should(Equal(1))
+
+				setAppStatus(app_examiner.AppInfo{
+					ActualInstances: []app_examiner.InstanceInfo{
+						app_examiner.InstanceInfo{Index: 0, State: "RUNNING"},
+						app_examiner.InstanceInfo{Index: 1, State: "CLAIMED", CrashCount: 2, CrashReason: "Exited with status 1"},
+					},
+				})
+				clock.IncrementBySeconds(1)
+				Eventually(appExaminer.AppStatusCallCount).Should(Equal(2))
+				Expect(commandFinishChan).ShouldNot(BeClosed())
+				Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
+
+				setAppStatus(app_examiner.AppInfo{
+					ActualInstances: []app_examiner.InstanceInfo{
+						app_examiner.InstanceInfo{Index: 0, State: "RUNNING"},
+						app_examiner.InstanceInfo{Index: 1, State: "CRASHED", CrashCount: 3, CrashReason: "Exited with status 1"},
+					},
+				})
+				clock.IncrementBySeconds(1)
+
+				Eventually(commandFinishChan).Should(BeClosed())
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.CrashLoop}))
+				Expect(outputBuffer).To(test_helpers.Say(colors.Red("Error, cool-web-app instance 1 has crashed 3 times: Exited with status 1")))
+				Expect(outputBuffer).ToNot(test_helpers.Say(colors.Green("cool-web-app is now running.")))
+				Expect(outputBuffer).ToNot(test_helpers.Say(colors.Red("cool-web-app took too long to start.")))
+			})
+		})
+
 		Context("invalid syntax", func() {
 			It("validates the CPU weight is in 1-100", func() {
 				args := []string{
@@ -690,8 +847,44 @@ var _ = Describe("CommandFactory", func() {
 				test_helpers.ExecuteCommandWithArgs(createCommand, args)
 
 				Expect(outputBuffer).To(test_helpers.Say("Error Creating App: Major Fault"))
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
 			})
 		})
+
+		Context("when --no-wait is passed", func() {
+			It("creates the app without polling or tailing logs", func() {
+				args := []string{
+					"--no-wait",
+					"cool-web-app",
+					"superfun/app",
+					"--",
+					"/start-me-please",
+				}
+				dockerMetadataFetcher.FetchMetadataReturns(&docker_metadata_fetcher.ImageMetadata{}, nil)
+
+				test_helpers.ExecuteCommandWithArgs(createCommand, args)
+
+				Expect(appRunner.CreateDockerAppCallCount()).To(Equal(1))
+				Expect(outputBuffer).To(test_helpers.Say("Creating App: cool-web-app\n"))
+				Expect(outputBuffer).To(test_helpers.Say("Not waiting for cool-web-app to start. Run 'ltc wait cool-web-app' to wait for it.\n"))
+				Expect(outputBuffer).To(test_helpers.Say(colors.Green("http://cool-web-app.192.168.11.11.xip.io\n")))
+				Expect(appRunner.RunningAppInstancesInfoCallCount()).To(BeZero())
+				Expect(fakeTailedLogsOutputter.OutputTailedLogsCallCount()).To(BeZero())
+			})
+		})
+
+		It("validates the --timeout flag", func() {
+			args := []string{
+				"--timeout=0s",
+				"cool-web-app",
+				"superfun/app",
+			}
+
+			test_helpers.ExecuteCommandWithArgs(createCommand, args)
+
+			Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: Timeout must be a positive duration (e.g., 5m)"))
+			Expect(appRunner.CreateDockerAppCallCount()).To(BeZero())
+		})
 	})
 
 	Describe("ScaleAppCommand", func() {
@@ -700,8 +893,9 @@ var _ = Describe("CommandFactory", func() {
 
 		BeforeEach(func() {
 			appRunnerCommandFactoryConfig = command_factory.AppRunnerCommandFactoryConfig{
-				AppRunner: appRunner,
-				UI:        terminalUI,
+				AppRunner:   appRunner,
+				AppExaminer: appExaminer,
+				UI:          terminalUI,
 				DockerMetadataFetcher: dockerMetadataFetcher,
 				Timeout:               timeout,
 				Domain:                domain,
@@ -784,6 +978,22 @@ var _ = Describe("CommandFactory", func() {
 
 			Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to scale.")))
 			Expect(outputBuffer).To(test_helpers.SayNewLine())
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
+		})
+
+		It("does not poll when --no-wait is passed", func() {
+			args := []string{
+				"--no-wait",
+				"cool-web-app",
+				"22",
+			}
+
+			test_helpers.ExecuteCommandWithArgs(scaleCommand, args)
+
+			Expect(appRunner.ScaleAppCallCount()).To(Equal(1))
+			Expect(outputBuffer).To(test_helpers.Say("Scaling cool-web-app to 22 instances"))
+			Expect(outputBuffer).To(test_helpers.Say("Not waiting for cool-web-app to scale. Run 'ltc wait cool-web-app --for=instances=22' to wait for it.\n"))
+			Expect(appRunner.RunningAppInstancesInfoCallCount()).To(BeZero())
 		})
 
 		Context("when the receptor returns errors", func() {
@@ -797,6 +1007,7 @@ var _ = Describe("CommandFactory", func() {
 				test_helpers.ExecuteCommandWithArgs(scaleCommand, args)
 
 				Expect(outputBuffer).To(test_helpers.Say("Error Scaling App to 22 instances: Major Fault"))
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
 			})
 		})
 
@@ -836,6 +1047,36 @@ var _ = Describe("CommandFactory", func() {
 			})
 		})
 
+		Context("when instances had already crashed before scaling", func() {
+			It("only counts crashes that happen while polling", func() {
+				args := []string{
+					"cool-web-app",
+					"7",
+				}
+
+				appRunner.RunningAppInstancesInfoReturns(0, false, nil)
+				appExaminer.AppStatusReturns(app_examiner.AppInfo{
+					ActualInstances: []app_examiner.InstanceInfo{
+						app_examiner.InstanceInfo{Index: 0, State: "CRASHED", CrashCount: 12},
+					},
+				}, nil)
+
+				commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(scaleCommand, args)
+
+				Eventually(appExaminer.AppStatusCallCount).Should(Equal(1))
+				clock.IncrementBySeconds(1)
+				Eventually(appExaminer.AppStatusCallCount).Should(Equal(2))
+				Expect(commandFinishChan).ShouldNot(BeClosed())
+				Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
+
+				clock.IncrementBySeconds(10)
+
+				Eventually(commandFinishChan).Should(BeClosed())
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
+				Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to scale.")))
+			})
+		})
+
 		Context("when there is a placement error when polling for the app to scale", func() {
 			It("Prints an error message and exits", func() {
 				args := []string{
@@ -876,14 +1117,16 @@ var _ = Describe("CommandFactory", func() {
 
 		BeforeEach(func() {
 			appRunnerCommandFactoryConfig = command_factory.AppRunnerCommandFactoryConfig{
-				AppRunner: appRunner,
-				UI:        terminalUI,
+				AppRunner:   appRunner,
+				AppExaminer: appExaminer,
+				UI:          terminalUI,
 				DockerMetadataFetcher: dockerMetadataFetcher,
 				Timeout:               timeout,
 				Domain:                domain,
 				Env:                   []string{},
 				Clock:                 clock,
 				Logger:                logger,
+				ExitHandler:           fakeExitHandler,
 			}
 
 			commandFactory := command_factory.NewAppRunnerCommandFactory(appRunnerCommandFactoryConfig)
@@ -930,6 +1173,7 @@ var _ = Describe("CommandFactory", func() {
 				test_helpers.ExecuteCommandWithArgs(updateRoutesCommand, args)
 
 				Expect(outputBuffer).To(test_helpers.Say("Error updating routes: Major Fault"))
+				Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
 				Expect(appRunner.UpdateAppRoutesCallCount()).To(Equal(1))
 			})
 		})
@@ -992,14 +1236,16 @@ var _ = Describe("CommandFactory", func() {
 
 		BeforeEach(func() {
 			appRunnerCommandFactoryConfig = command_factory.AppRunnerCommandFactoryConfig{
-				AppRunner: appRunner,
-				UI:        terminalUI,
+				AppRunner:   appRunner,
+				AppExaminer: appExaminer,
+				UI:          terminalUI,
 				DockerMetadataFetcher: dockerMetadataFetcher,
 				Timeout:               timeout,
 				Domain:                domain,
 				Env:                   []string{},
 				Clock:                 clock,
 				Logger:                logger,
+				ExitHandler:           fakeExitHandler,
 			}
 
 			commandFactory := command_factory.NewAppRunnerCommandFactory(appRunnerCommandFactoryConfig)
@@ -1066,6 +1312,7 @@ var _ = Describe("CommandFactory", func() {
 			Eventually(commandFinishChan).Should(BeClosed())
 
 			Expect(outputBuffer).To(test_helpers.Say(colors.Red("Failed to remove cool-web-app.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
 		})
 
 		It("alerts the user if the app runner returns an error", func() {
@@ -1083,6 +1330,7 @@ var _ = Describe("CommandFactory", func() {
 
 			Eventually(commandFinishChan).Should(BeClosed())
 			Expect(outputBuffer).To(test_helpers.Say(colors.Red("Failed to remove cool-web-app.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
 		})
 
 		It("validates that the name is passed in", func() {
@@ -1105,6 +1353,161 @@ var _ = Describe("CommandFactory", func() {
 			test_helpers.ExecuteCommandWithArgs(removeCommand, args)
 
 			Expect(outputBuffer).To(test_helpers.Say("Error Stopping App: Major Fault"))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
+		})
+
+		It("does not poll when --no-wait is passed", func() {
+			args := []string{
+				"--no-wait",
+				"cool-web-app",
+			}
+
+			test_helpers.ExecuteCommandWithArgs(removeCommand, args)
+
+			Expect(appRunner.RemoveAppCallCount()).To(Equal(1))
+			Expect(outputBuffer).To(test_helpers.Say("Removing cool-web-app\n"))
+			Expect(outputBuffer).To(test_helpers.Say("Not waiting for cool-web-app to be removed. Run 'ltc wait cool-web-app --for=removed' to wait for it.\n"))
+			Expect(appRunner.AppExistsCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("WaitCommand", func() {
+		var waitCommand cli.Command
+
+		BeforeEach(func() {
+			appRunnerCommandFactoryConfig = command_factory.AppRunnerCommandFactoryConfig{
+				AppRunner:   appRunner,
+				AppExaminer: appExaminer,
+				UI:          terminalUI,
+				Timeout:     timeout,
+				Domain:      domain,
+				Clock:       clock,
+				Logger:      logger,
+				ExitHandler: fakeExitHandler,
+			}
+
+			commandFactory := command_factory.NewAppRunnerCommandFactory(appRunnerCommandFactoryConfig)
+			waitCommand = commandFactory.MakeWaitCommand()
+
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{ProcessGuid: "cool-web-app", DesiredInstances: 3}, nil)
+		})
+
+		It("waits for the desired instances to be running by default", func() {
+			appRunner.RunningAppInstancesInfoReturns(1, false, nil)
+
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(waitCommand, []string{"cool-web-app"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Waiting for 3 instances of cool-web-app to be running"))
+			Expect(appExaminer.AppStatusArgsForCall(0)).To(Equal("cool-web-app"))
+
+			appRunner.RunningAppInstancesInfoReturns(3, false, nil)
+			clock.IncrementBySeconds(1)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("cool-web-app has 3 running instances.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
+		})
+
+		It("waits for the given number of instances", func() {
+			appRunner.RunningAppInstancesInfoReturns(7, false, nil)
+
+			test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"--for=instances=7", "cool-web-app"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Waiting for 7 instances of cool-web-app to be running"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("cool-web-app has 7 running instances.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
+		})
+
+		It("waits for the app to be removed", func() {
+			appRunner.AppExistsReturns(true, nil)
+
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(waitCommand, []string{"-f", "removed", "cool-web-app"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Waiting for cool-web-app to be removed"))
+			Expect(appRunner.AppExistsArgsForCall(0)).To(Equal("cool-web-app"))
+
+			appRunner.AppExistsReturns(false, nil)
+			clock.IncrementBySeconds(1)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("cool-web-app has been removed.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(BeEmpty())
+		})
+
+		It("exits with the timeout exit code when the app does not converge in time", func() {
+			appRunner.RunningAppInstancesInfoReturns(1, false, nil)
+
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(waitCommand, []string{"--timeout=5m", "cool-web-app"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Waiting for 3 instances of cool-web-app to be running"))
+
+			clock.IncrementBySeconds(60)
+			Consistently(commandFinishChan).ShouldNot(BeClosed())
+
+			clock.Increment(5 * time.Minute)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to start.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
+		})
+
+		It("exits with the timeout exit code when the app is not removed in time", func() {
+			appRunner.AppExistsReturns(true, nil)
+
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(waitCommand, []string{"--for=removed", "cool-web-app"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Waiting for cool-web-app to be removed"))
+			clock.IncrementBySeconds(10)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("cool-web-app took too long to be removed.")))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Timeout}))
+		})
+
+		It("exits with the placement error exit code when instances cannot be placed", func() {
+			appRunner.RunningAppInstancesInfoReturns(1, true, nil)
+
+			test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"cool-web-app"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error, could not place all instances"))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.PlacementError}))
+		})
+
+		It("exits with the app not found exit code when the app does not exist", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"cool-web-app"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error waiting for cool-web-app: App not found."))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.AppNotFound}))
+		})
+
+		Context("invalid syntax", func() {
+			It("validates that the name is passed in", func() {
+				test_helpers.ExecuteCommandWithArgs(waitCommand, []string{})
+
+				Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: App Name required"))
+			})
+
+			It("validates the --for flag", func() {
+				test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"--for=happy", "cool-web-app"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: --for must be one of running, removed or instances=N"))
+				Expect(appExaminer.AppStatusCallCount()).To(BeZero())
+			})
+
+			It("validates the number of instances", func() {
+				test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"--for=instances=lots", "cool-web-app"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: Number of Instances must be a non-negative integer"))
+				Expect(appRunner.RunningAppInstancesInfoCallCount()).To(BeZero())
+			})
+
+			It("validates the --timeout flag", func() {
+				test_helpers.ExecuteCommandWithArgs(waitCommand, []string{"--timeout=-1s", "cool-web-app"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: Timeout must be a positive duration (e.g., 5m)"))
+			})
 		})
 	})
 
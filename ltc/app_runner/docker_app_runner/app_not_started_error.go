@@ -2,12 +2,12 @@ package docker_app_runner
 
 import "fmt"
 
-type appNotStartedError string
+type AppNotStartedError string
 
-func newAppNotStartedError(appName string) appNotStartedError {
-	return appNotStartedError(appName)
+func newAppNotStartedError(appName string) AppNotStartedError {
+	return AppNotStartedError(appName)
 }
 
-func (appName appNotStartedError) Error() string {
+func (appName AppNotStartedError) Error() string {
 	return fmt.Sprintf("%s, is not started. Please start an app first", string(appName))
 }
@@ -2,12 +2,12 @@ package docker_app_runner
 
 import "fmt"
 
-type existingAppError string
+type ExistingAppError string
 
-func newExistingAppError(appName string) existingAppError {
-	return existingAppError(appName)
+func newExistingAppError(appName string) ExistingAppError {
+	return ExistingAppError(appName)
 }
 
-func (appName existingAppError) Error() string {
+func (appName ExistingAppError) Error() string {
 	return fmt.Sprintf("App %s, is already running", string(appName))
 }
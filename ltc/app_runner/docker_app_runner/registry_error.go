@@ -0,0 +1,14 @@
+package docker_app_runner
+
+type RegistryError struct {
+	DockerImage string
+	Err         error
+}
+
+func NewRegistryError(dockerImage string, err error) RegistryError {
+	return RegistryError{dockerImage, err}
+}
+
+func (registryError RegistryError) Error() string {
+	return registryError.Err.Error()
+}
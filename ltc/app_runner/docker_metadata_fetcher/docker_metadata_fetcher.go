@@ -31,8 +31,17 @@ func New(sessionFactory DockerSessionFactory) DockerMetadataFetcher {
 	}
 }
 
+// FetchMetadata returns a docker_app_runner.RegistryError for any failure to
+// look the image up.
 func (fetcher *dockerMetadataFetcher) FetchMetadata(dockerImageReference string) (*ImageMetadata, error) {
+	imageMetadata, err := fetcher.fetchMetadata(dockerImageReference)
+	if err != nil {
+		return nil, docker_app_runner.NewRegistryError(dockerImageReference, err)
+	}
+	return imageMetadata, nil
+}
 
+func (fetcher *dockerMetadataFetcher) fetchMetadata(dockerImageReference string) (*ImageMetadata, error) {
 	var indexAndRepoName string
 	indexName, repoName, tag, err := docker_repository_name_formatter.ParseRepoNameAndTagFromImageReference(dockerImageReference)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_metadata_fetcher"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_metadata_fetcher/fake_docker_session"
 	"github.com/docker/docker/registry"
@@ -211,6 +212,7 @@ var _ = Describe("DockerMetaDataFetcher", func() {
 				_, err := dockerMetadataFetcher.FetchMetadata("verybad/apple")
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("Couldn't make a session."))
+				Expect(err).To(Equal(docker_app_runner.NewRegistryError("verybad/apple", errors.New("Couldn't make a session."))))
 			})
 		})
 
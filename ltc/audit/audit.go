@@ -0,0 +1,167 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/config"
+	"github.com/pivotal-golang/clock"
+)
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Sources of audit entries, telling which of ltc's front ends made a change.
+const (
+	SourceCLI       = "ltc"
+	SourceServe     = "ltc serve"
+	SourceClient    = "lattice client"
+	SourceTerraform = "terraform"
+)
+
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Target    string    `json:"target"`
+	Source    string    `json:"source,omitempty"`
+	Command   string    `json:"command"`
+	App       string    `json:"app,omitempty"`
+	Arguments []string  `json:"arguments"`
+	Outcome   Outcome   `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// RedactedValue replaces the values of environment variables in the audit log.
+const RedactedValue = "[REDACTED]"
+
+//go:generate counterfeiter -o fake_auditor/fake_auditor.go . Auditor
+type Auditor interface {
+	Record(entry Entry, err error) error
+	Entries() ([]Entry, error)
+}
+
+type auditor struct {
+	logFilePath string
+	config      *config.Config
+	hook        Hook
+	clock       clock.Clock
+	lock        sync.Mutex
+}
+
+// New returns an auditor appending entries to the log at logFilePath and
+// notifying hook, if there is one. An empty logFilePath only notifies the hook.
+func New(logFilePath string, config *config.Config, hook Hook, clock clock.Clock) Auditor {
+	return &auditor{
+		logFilePath: logFilePath,
+		config:      config,
+		hook:        hook,
+		clock:       clock,
+	}
+}
+
+func (a *auditor) Record(entry Entry, err error) error {
+	entry.Timestamp = a.clock.Now().UTC()
+	entry.User = currentUser()
+	entry.Target = a.config.Target()
+	entry.Outcome = OutcomeSuccess
+	if err != nil {
+		entry.Outcome = OutcomeFailure
+		entry.Error = err.Error()
+	}
+	if entry.Arguments == nil {
+		entry.Arguments = []string{}
+	}
+
+	if err := a.appendEntry(entry); err != nil {
+		return err
+	}
+
+	if a.hook != nil {
+		if err := a.hook.Notify(entry); err != nil {
+			return &HookError{Err: err}
+		}
+	}
+	return nil
+}
+
+// Warning describes an error returned by Record to the user, telling a
+// failing hook apart from an audit log that could not be written.
+func Warning(err error) string {
+	if hookErr, ok := err.(*HookError); ok {
+		return "Warning: " + hookErr.Error()
+	}
+	return "Warning: unable to write audit log: " + err.Error()
+}
+
+func (a *auditor) Entries() ([]Entry, error) {
+	if a.logFilePath == "" {
+		return []Entry{}, nil
+	}
+
+	logFile, err := os.Open(a.logFilePath)
+	if os.IsNotExist(err) {
+		return []Entry{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer logFile.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(logFile)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+func (a *auditor) appendEntry(entry Entry) error {
+	if a.logFilePath == "" {
+		return nil
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(a.logFilePath), 0700); err != nil {
+		return err
+	}
+
+	logFile, err := os.OpenFile(a.logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer logFile.Close()
+
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = logFile.Write(append(entryJSON, '\n'))
+	return err
+}
+
+func currentUser() string {
+	if currentUser, err := user.Current(); err == nil {
+		return currentUser.Username
+	}
+	return os.Getenv("USER")
+}
@@ -0,0 +1,224 @@
+package audit_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	config_package "github.com/cloudfoundry-incubator/lattice/ltc/config"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/persister"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+var _ = Describe("Auditor", func() {
+	var (
+		tmpDir      string
+		logFilePath string
+		config      *config_package.Config
+		clock       *fakeclock.FakeClock
+		hook        audit.Hook
+		auditor     audit.Auditor
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "audit")
+		Expect(err).ToNot(HaveOccurred())
+
+		logFilePath = filepath.Join(tmpDir, ".lattice", "audit.log")
+		config = config_package.New(persister.NewMemPersister())
+		config.SetTarget("lattice.example.com")
+		clock = fakeclock.NewFakeClock(time.Date(2015, 4, 1, 13, 14, 15, 0, time.UTC))
+		hook = nil
+	})
+
+	JustBeforeEach(func() {
+		auditor = audit.New(logFilePath, config, hook, clock)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("Record", func() {
+		It("appends entries as JSON lines", func() {
+			Expect(auditor.Record(audit.Entry{Command: "scale", App: "cool-web-app", Arguments: []string{"cool-web-app", "3"}}, nil)).To(Succeed())
+			clock.IncrementBySeconds(5)
+			Expect(auditor.Record(audit.Entry{Command: "remove", App: "cool-web-app", Arguments: []string{"cool-web-app"}}, errors.New("app not found"))).To(Succeed())
+
+			logContents, err := ioutil.ReadFile(logFilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(logContents)).To(MatchRegexp(`^\{.*"command":"scale".*\}\n\{.*"command":"remove".*\}\n$`))
+
+			entries, err := auditor.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+
+			Expect(entries[0].Timestamp).To(Equal(time.Date(2015, 4, 1, 13, 14, 15, 0, time.UTC)))
+			Expect(entries[0].User).ToNot(BeEmpty())
+			Expect(entries[0].Target).To(Equal("lattice.example.com"))
+			Expect(entries[0].Command).To(Equal("scale"))
+			Expect(entries[0].App).To(Equal("cool-web-app"))
+			Expect(entries[0].Arguments).To(Equal([]string{"cool-web-app", "3"}))
+			Expect(entries[0].Outcome).To(Equal(audit.OutcomeSuccess))
+			Expect(entries[0].Error).To(BeEmpty())
+
+			Expect(entries[1].Timestamp).To(Equal(time.Date(2015, 4, 1, 13, 14, 20, 0, time.UTC)))
+			Expect(entries[1].Outcome).To(Equal(audit.OutcomeFailure))
+			Expect(entries[1].Error).To(Equal("app not found"))
+		})
+
+		It("creates the audit log readable only by the user", func() {
+			Expect(auditor.Record(audit.Entry{Command: "target"}, nil)).To(Succeed())
+
+			fileInfo, err := os.Stat(logFilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fileInfo.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("returns an error when the audit log cannot be written", func() {
+			Expect(ioutil.WriteFile(filepath.Join(tmpDir, ".lattice"), []byte("not a directory"), 0600)).To(Succeed())
+
+			Expect(auditor.Record(audit.Entry{Command: "target"}, nil)).ToNot(Succeed())
+		})
+
+		Context("when a command hook is configured", func() {
+			var hookOutputPath string
+
+			BeforeEach(func() {
+				hookOutputPath = filepath.Join(tmpDir, "hook-output")
+				hook = audit.NewHook("cat > " + hookOutputPath)
+			})
+
+			It("pipes the entry to the command", func() {
+				Expect(auditor.Record(audit.Entry{Command: "scale", App: "cool-web-app"}, nil)).To(Succeed())
+
+				hookOutput, err := ioutil.ReadFile(hookOutputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(hookOutput)).To(ContainSubstring(`"command":"scale"`))
+				Expect(string(hookOutput)).To(ContainSubstring(`"outcome":"success"`))
+			})
+
+			It("returns an error when the command fails", func() {
+				hook = audit.NewHook("echo nope >&2; exit 3")
+				auditor = audit.New(logFilePath, config, hook, clock)
+
+				err := auditor.Record(audit.Entry{Command: "scale"}, nil)
+				Expect(err).To(MatchError("audit hook failed: exit status 3: nope"))
+				Expect(err).To(BeAssignableToTypeOf(&audit.HookError{}))
+
+				entries, err := auditor.Entries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+			})
+
+			It("kills the command when it takes longer than the hook timeout", func() {
+				defer func(timeout time.Duration) { audit.HookTimeout = timeout }(audit.HookTimeout)
+				audit.HookTimeout = 100 * time.Millisecond
+				hook = audit.NewHook("exec sleep 10")
+				auditor = audit.New(logFilePath, config, hook, clock)
+
+				start := time.Now()
+				err := auditor.Record(audit.Entry{Command: "scale"}, nil)
+				Expect(err).To(MatchError("audit hook failed: exec sleep 10 timed out after 100ms"))
+				Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			})
+
+			It("only runs the hook when there is no audit log", func() {
+				auditor = audit.New("", config, hook, clock)
+
+				Expect(auditor.Record(audit.Entry{Command: "scale", App: "cool-web-app"}, nil)).To(Succeed())
+
+				_, err := os.Stat(hookOutputPath)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = os.Stat(filepath.Join(tmpDir, ".lattice"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				entries, err := auditor.Entries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+		})
+
+		Context("when a webhook is configured", func() {
+			var fakeServer *ghttp.Server
+
+			BeforeEach(func() {
+				fakeServer = ghttp.NewServer()
+				hook = audit.NewHook(fakeServer.URL() + "/audit")
+			})
+
+			AfterEach(func() {
+				fakeServer.Close()
+			})
+
+			It("posts the entry to the webhook", func() {
+				fakeServer.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/audit"),
+					ghttp.VerifyHeader(http.Header{"Content-Type": []string{"application/json"}}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				))
+
+				Expect(auditor.Record(audit.Entry{Command: "remove", App: "cool-web-app"}, nil)).To(Succeed())
+				Expect(fakeServer.ReceivedRequests()).To(HaveLen(1))
+			})
+
+			It("returns an error when the webhook does not accept the entry", func() {
+				fakeServer.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+
+				Expect(auditor.Record(audit.Entry{Command: "remove"}, nil)).To(MatchError("audit hook failed: " + fakeServer.URL() + "/audit returned 500 Internal Server Error"))
+			})
+
+			It("gives up when the webhook takes longer than the hook timeout", func() {
+				defer func(timeout time.Duration) { audit.HookTimeout = timeout }(audit.HookTimeout)
+				audit.HookTimeout = 100 * time.Millisecond
+				hook = audit.NewHook(fakeServer.URL() + "/audit")
+				auditor = audit.New(logFilePath, config, hook, clock)
+
+				unblock := make(chan struct{})
+				defer close(unblock)
+				fakeServer.AppendHandlers(func(http.ResponseWriter, *http.Request) {
+					<-unblock
+				})
+
+				start := time.Now()
+				err := auditor.Record(audit.Entry{Command: "remove"}, nil)
+				Expect(err).To(BeAssignableToTypeOf(&audit.HookError{}))
+				Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			})
+		})
+	})
+
+	Describe("Warning", func() {
+		It("reports a failing hook", func() {
+			Expect(audit.Warning(&audit.HookError{Err: errors.New("exit status 3: nope")})).To(Equal("Warning: audit hook failed: exit status 3: nope"))
+		})
+
+		It("reports an audit log that could not be written", func() {
+			Expect(audit.Warning(errors.New("disk full"))).To(Equal("Warning: unable to write audit log: disk full"))
+		})
+	})
+
+	Describe("Entries", func() {
+		It("returns no entries when nothing has been recorded", func() {
+			entries, err := auditor.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("returns an error when the audit log is corrupt", func() {
+			Expect(os.MkdirAll(filepath.Dir(logFilePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(logFilePath, []byte("{not json\n"), 0600)).To(Succeed())
+
+			_, err := auditor.Entries()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,126 @@
+package audited_app_runner
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+)
+
+type auditedAppRunner struct {
+	docker_app_runner.AppRunner
+	auditor audit.Auditor
+	source  string
+	warn    func(error)
+}
+
+// New wraps appRunner so that every app it creates, scales, re-routes or
+// removes is recorded with auditor, tagged with the source that asked for it.
+// Errors recording an entry are passed to warn and never fail the operation.
+func New(appRunner docker_app_runner.AppRunner, auditor audit.Auditor, source string, warn func(error)) docker_app_runner.AppRunner {
+	return &auditedAppRunner{
+		AppRunner: appRunner,
+		auditor:   auditor,
+		source:    source,
+		warn:      warn,
+	}
+}
+
+func (r *auditedAppRunner) CreateDockerApp(params docker_app_runner.CreateDockerAppParams) error {
+	err := r.AppRunner.CreateDockerApp(params)
+	r.record("create", params.Name, createArguments(params), err)
+	return err
+}
+
+func (r *auditedAppRunner) ScaleApp(name string, instances int) error {
+	err := r.AppRunner.ScaleApp(name, instances)
+	r.record("scale", name, []string{name, strconv.Itoa(instances)}, err)
+	return err
+}
+
+func (r *auditedAppRunner) UpdateAppRoutes(name string, routes docker_app_runner.RouteOverrides) error {
+	err := r.AppRunner.UpdateAppRoutes(name, routes)
+	r.record("update-routes", name, []string{name, formatRoutes(routes)}, err)
+	return err
+}
+
+func (r *auditedAppRunner) RemoveApp(name string) error {
+	err := r.AppRunner.RemoveApp(name)
+	r.record("remove", name, []string{name}, err)
+	return err
+}
+
+func (r *auditedAppRunner) record(command, appName string, arguments []string, err error) {
+	entry := audit.Entry{
+		Source:    r.source,
+		Command:   command,
+		App:       appName,
+		Arguments: arguments,
+	}
+
+	if auditErr := r.auditor.Record(entry, err); auditErr != nil && r.warn != nil {
+		r.warn(auditErr)
+	}
+}
+
+// createArguments describes params with the flags of ltc create, redacting
+// environment variable values so secrets never reach the audit log.
+func createArguments(params docker_app_runner.CreateDockerAppParams) []string {
+	arguments := []string{params.Name, params.DockerImagePath}
+
+	envNames := []string{}
+	for name := range params.EnvironmentVariables {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+	for _, name := range envNames {
+		arguments = append(arguments, fmt.Sprintf("--env=%s=%s", name, audit.RedactedValue))
+	}
+
+	arguments = append(arguments,
+		fmt.Sprintf("--instances=%d", params.Instances),
+		fmt.Sprintf("--cpu-weight=%d", params.CPUWeight),
+		fmt.Sprintf("--memory-mb=%d", params.MemoryMB),
+		fmt.Sprintf("--disk-mb=%d", params.DiskMB),
+	)
+
+	if len(params.Ports.Exposed) > 0 {
+		ports := []string{}
+		for _, port := range params.Ports.Exposed {
+			ports = append(ports, strconv.Itoa(int(port)))
+		}
+		arguments = append(arguments, "--ports="+strings.Join(ports, ","))
+	}
+	if params.Monitor {
+		arguments = append(arguments, fmt.Sprintf("--monitored-port=%d", params.Ports.Monitored))
+	} else {
+		arguments = append(arguments, "--no-monitor=true")
+	}
+	if len(params.RouteOverrides) > 0 {
+		arguments = append(arguments, "--routes="+formatRoutes(params.RouteOverrides))
+	}
+	if params.WorkingDir != "" {
+		arguments = append(arguments, "--working-dir="+params.WorkingDir)
+	}
+	if params.Privileged {
+		arguments = append(arguments, "--run-as-root=true")
+	}
+	if params.StartCommand != "" {
+		arguments = append(arguments, "--", params.StartCommand)
+		arguments = append(arguments, params.AppArgs...)
+	}
+
+	return arguments
+}
+
+// formatRoutes writes routes as ltc's --routes flag takes them.
+func formatRoutes(routes docker_app_runner.RouteOverrides) string {
+	formattedRoutes := []string{}
+	for _, route := range routes {
+		formattedRoutes = append(formattedRoutes, fmt.Sprintf("%d:%s", route.Port, route.HostnamePrefix))
+	}
+	return strings.Join(formattedRoutes, ",")
+}
@@ -0,0 +1,13 @@
+package audited_app_runner_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAuditedAppRunner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AuditedAppRunner Suite")
+}
@@ -0,0 +1,136 @@
+package audited_app_runner_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/audited_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/fake_auditor"
+)
+
+var _ = Describe("AuditedAppRunner", func() {
+	var (
+		fakeAppRunner *fake_app_runner.FakeAppRunner
+		fakeAuditor   *fake_auditor.FakeAuditor
+		warnings      []error
+		appRunner     docker_app_runner.AppRunner
+	)
+
+	BeforeEach(func() {
+		fakeAppRunner = &fake_app_runner.FakeAppRunner{}
+		fakeAuditor = &fake_auditor.FakeAuditor{}
+		warnings = []error{}
+		appRunner = audited_app_runner.New(fakeAppRunner, fakeAuditor, audit.SourceServe, func(err error) {
+			warnings = append(warnings, err)
+		})
+	})
+
+	Describe("CreateDockerApp", func() {
+		It("creates the app and records it with the flags of ltc create", func() {
+			params := docker_app_runner.CreateDockerAppParams{
+				Name:                 "cool-web-app",
+				DockerImagePath:      "superfun/app",
+				StartCommand:         "/start-me-please",
+				AppArgs:              []string{"AppArg0"},
+				EnvironmentVariables: map[string]string{"TIMEZONE": "CST", "AWS_SECRET": "s3cr3t"},
+				Privileged:           true,
+				Monitor:              true,
+				Instances:            22,
+				CPUWeight:            67,
+				MemoryMB:             128,
+				DiskMB:               1024,
+				Ports:                docker_app_runner.PortConfig{Monitored: 2000, Exposed: []uint16{2000, 4000}},
+				WorkingDir:           "/applications",
+				RouteOverrides:       docker_app_runner.RouteOverrides{{HostnamePrefix: "route-3000", Port: 2000}},
+			}
+
+			Expect(appRunner.CreateDockerApp(params)).To(Succeed())
+
+			Expect(fakeAppRunner.CreateDockerAppCallCount()).To(Equal(1))
+			Expect(fakeAppRunner.CreateDockerAppArgsForCall(0)).To(Equal(params))
+
+			Expect(fakeAuditor.RecordCallCount()).To(Equal(1))
+			entry, err := fakeAuditor.RecordArgsForCall(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entry).To(Equal(audit.Entry{
+				Source:  audit.SourceServe,
+				Command: "create",
+				App:     "cool-web-app",
+				Arguments: []string{
+					"cool-web-app",
+					"superfun/app",
+					"--env=AWS_SECRET=[REDACTED]",
+					"--env=TIMEZONE=[REDACTED]",
+					"--instances=22",
+					"--cpu-weight=67",
+					"--memory-mb=128",
+					"--disk-mb=1024",
+					"--ports=2000,4000",
+					"--monitored-port=2000",
+					"--routes=2000:route-3000",
+					"--working-dir=/applications",
+					"--run-as-root=true",
+					"--",
+					"/start-me-please",
+					"AppArg0",
+				},
+			}))
+		})
+
+		It("records unmonitored apps", func() {
+			Expect(appRunner.CreateDockerApp(docker_app_runner.CreateDockerAppParams{Name: "cool-web-app", DockerImagePath: "superfun/app"})).To(Succeed())
+
+			entry, _ := fakeAuditor.RecordArgsForCall(0)
+			Expect(entry.Arguments).To(ContainElement("--no-monitor=true"))
+		})
+	})
+
+	It("records scaling an app", func() {
+		Expect(appRunner.ScaleApp("cool-web-app", 3)).To(Succeed())
+
+		Expect(fakeAppRunner.ScaleAppCallCount()).To(Equal(1))
+		entry, err := fakeAuditor.RecordArgsForCall(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entry).To(Equal(audit.Entry{Source: audit.SourceServe, Command: "scale", App: "cool-web-app", Arguments: []string{"cool-web-app", "3"}}))
+	})
+
+	It("records updating an app's routes", func() {
+		routes := docker_app_runner.RouteOverrides{{HostnamePrefix: "foo.com", Port: 8080}, {HostnamePrefix: "bar.com", Port: 9090}}
+		Expect(appRunner.UpdateAppRoutes("cool-web-app", routes)).To(Succeed())
+
+		Expect(fakeAppRunner.UpdateAppRoutesCallCount()).To(Equal(1))
+		entry, _ := fakeAuditor.RecordArgsForCall(0)
+		Expect(entry).To(Equal(audit.Entry{Source: audit.SourceServe, Command: "update-routes", App: "cool-web-app", Arguments: []string{"cool-web-app", "8080:foo.com,9090:bar.com"}}))
+	})
+
+	It("records failing to remove an app and returns the error", func() {
+		fakeAppRunner.RemoveAppReturns(errors.New("app not found"))
+
+		Expect(appRunner.RemoveApp("cool-web-app")).To(MatchError("app not found"))
+
+		entry, err := fakeAuditor.RecordArgsForCall(0)
+		Expect(entry).To(Equal(audit.Entry{Source: audit.SourceServe, Command: "remove", App: "cool-web-app", Arguments: []string{"cool-web-app"}}))
+		Expect(err).To(MatchError("app not found"))
+	})
+
+	It("does not record reads", func() {
+		fakeAppRunner.AppExistsReturns(true, nil)
+
+		Expect(appRunner.AppExists("cool-web-app")).To(BeTrue())
+
+		Expect(fakeAuditor.RecordCallCount()).To(Equal(0))
+	})
+
+	It("warns when the entry cannot be recorded without failing the operation", func() {
+		fakeAuditor.RecordReturns(errors.New("disk full"))
+
+		Expect(appRunner.ScaleApp("cool-web-app", 3)).To(Succeed())
+
+		Expect(warnings).To(ConsistOf(MatchError("disk full")))
+	})
+})
@@ -0,0 +1,149 @@
+package command_factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock"
+)
+
+const TimestampDisplayLayout = "2006-01-02 15:04:05 (MST)"
+
+type AuditCommandFactory struct {
+	auditor     audit.Auditor
+	ui          terminal.UI
+	clock       clock.Clock
+	exitHandler exit_handler.ExitHandler
+}
+
+func NewAuditCommandFactory(auditor audit.Auditor, ui terminal.UI, clock clock.Clock, exitHandler exit_handler.ExitHandler) *AuditCommandFactory {
+	return &AuditCommandFactory{auditor, ui, clock, exitHandler}
+}
+
+func (factory *AuditCommandFactory) MakeAuditCommand() cli.Command {
+	var auditFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "app, a",
+			Usage: "Only show entries for the given app",
+		},
+		cli.StringFlag{
+			Name:  "command, c",
+			Usage: "Only show entries for the given command (e.g., scale)",
+		},
+		cli.DurationFlag{
+			Name:  "since, s",
+			Usage: "Only show entries newer than the given duration (e.g., \"24h\")",
+		},
+		cli.IntFlag{
+			Name:  "limit, n",
+			Usage: "Only show the given number of most recent entries",
+		},
+		cli.BoolFlag{
+			Name:  "json, j",
+			Usage: "Outputs the entries as JSON lines",
+		},
+	}
+
+	return cli.Command{
+		Name:        "audit",
+		ShortName:   "au",
+		Usage:       "Shows the local audit log of mutating ltc commands",
+		Description: "ltc audit [--app=APP_NAME] [--command=COMMAND] [--since=DURATION] [--limit=N] [--json]",
+		Action:      factory.showAuditLog,
+		Flags:       auditFlags,
+	}
+}
+
+func (factory *AuditCommandFactory) showAuditLog(context *cli.Context) {
+	appFlag := context.String("app")
+	commandFlag := context.String("command")
+	sinceFlag := context.Duration("since")
+	limitFlag := context.Int("limit")
+
+	if limitFlag < 0 {
+		factory.ui.IncorrectUsage("Limit must be a positive integer")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	entries, err := factory.auditor.Entries()
+	if err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error reading audit log: %s", err))
+		factory.exitHandler.Exit(exit_codes.GeneralError)
+		return
+	}
+
+	filteredEntries := []audit.Entry{}
+	for _, entry := range entries {
+		if appFlag != "" && entry.App != appFlag {
+			continue
+		}
+		if commandFlag != "" && entry.Command != commandFlag {
+			continue
+		}
+		if sinceFlag > 0 && entry.Timestamp.Before(factory.clock.Now().Add(-sinceFlag)) {
+			continue
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+
+	if limitFlag > 0 && len(filteredEntries) > limitFlag {
+		filteredEntries = filteredEntries[len(filteredEntries)-limitFlag:]
+	}
+
+	if context.Bool("json") {
+		for _, entry := range filteredEntries {
+			entryJSON, _ := json.Marshal(entry)
+			factory.ui.SayLine(string(entryJSON))
+		}
+		return
+	}
+
+	if len(filteredEntries) == 0 {
+		factory.ui.SayLine("No audit entries found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(factory.ui, 10, 8, 1, '\t', 0)
+	fmt.Fprintln(w, colors.Bold("Time")+"\t"+colors.Bold("User")+"\t"+colors.Bold("Target")+"\t"+colors.Bold("Source")+"\t"+colors.Bold("Command")+"\t"+colors.Bold("Arguments")+"\t"+colors.Bold("Outcome"))
+	for _, entry := range filteredEntries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			entry.Timestamp.Local().Format(TimestampDisplayLayout),
+			entry.User,
+			entry.Target,
+			formatSource(entry),
+			colors.Bold(entry.Command),
+			strings.Join(entry.Arguments, " "),
+			formatOutcome(entry),
+		)
+	}
+	w.Flush()
+}
+
+// formatSource shows entries recorded before sources were logged as coming
+// from ltc, the only thing that recorded them.
+func formatSource(entry audit.Entry) string {
+	if entry.Source == "" {
+		return audit.SourceCLI
+	}
+	return entry.Source
+}
+
+func formatOutcome(entry audit.Entry) string {
+	if entry.Outcome == audit.OutcomeSuccess {
+		return colors.Green(string(entry.Outcome))
+	}
+
+	if entry.Error == "" {
+		return colors.Red(string(entry.Outcome))
+	}
+	return colors.Red(fmt.Sprintf("%s: %s", entry.Outcome, entry.Error))
+}
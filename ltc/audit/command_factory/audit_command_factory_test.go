@@ -0,0 +1,130 @@
+package command_factory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/fake_auditor"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		auditor         *fake_auditor.FakeAuditor
+		outputBuffer    *gbytes.Buffer
+		terminalUI      terminal.UI
+		clock           *fakeclock.FakeClock
+		fakeExitHandler *fake_exit_handler.FakeExitHandler
+		auditCommand    cli.Command
+		now             time.Time
+	)
+
+	BeforeEach(func() {
+		auditor = &fake_auditor.FakeAuditor{}
+		outputBuffer = gbytes.NewBuffer()
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
+		now = time.Date(2015, 4, 1, 13, 14, 15, 0, time.UTC)
+		clock = fakeclock.NewFakeClock(now)
+		fakeExitHandler = &fake_exit_handler.FakeExitHandler{}
+
+		auditor.EntriesReturns([]audit.Entry{
+			{Timestamp: now.Add(-48 * time.Hour), User: "alice", Target: "lattice.example.com", Command: "target", Arguments: []string{"lattice.example.com"}, Outcome: audit.OutcomeSuccess},
+			{Timestamp: now.Add(-2 * time.Hour), User: "bob", Target: "lattice.example.com", Source: audit.SourceServe, Command: "scale", App: "cool-web-app", Arguments: []string{"cool-web-app", "3"}, Outcome: audit.OutcomeSuccess},
+			{Timestamp: now.Add(-1 * time.Hour), User: "alice", Target: "lattice.example.com", Command: "remove", App: "other-app", Arguments: []string{"other-app"}, Outcome: audit.OutcomeFailure, Error: "app not found"},
+		}, nil)
+
+		commandFactory := command_factory.NewAuditCommandFactory(auditor, terminalUI, clock, fakeExitHandler)
+		auditCommand = commandFactory.MakeAuditCommand()
+	})
+
+	Describe("AuditCommand", func() {
+		It("lists all audit entries", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Time")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("Outcome")))
+			Expect(outputBuffer).To(test_helpers.Say("alice"))
+			Expect(outputBuffer).To(test_helpers.Say("ltc"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("target")))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Green("success")))
+			Expect(outputBuffer).To(test_helpers.Say("bob"))
+			Expect(outputBuffer).To(test_helpers.Say("ltc serve"))
+			Expect(outputBuffer).To(test_helpers.Say("cool-web-app 3"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("failure: app not found")))
+		})
+
+		It("filters entries by app", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--app=cool-web-app"})
+
+			Expect(outputBuffer).To(test_helpers.Say("scale"))
+			Expect(outputBuffer).ToNot(test_helpers.Say("remove"))
+		})
+
+		It("filters entries by command", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--command=remove"})
+
+			Expect(outputBuffer).ToNot(test_helpers.Say("scale"))
+			Expect(outputBuffer).To(test_helpers.Say("other-app"))
+		})
+
+		It("filters entries by age", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--since=24h"})
+
+			Expect(outputBuffer).ToNot(test_helpers.Say(colors.Bold("target")))
+			Expect(outputBuffer).To(test_helpers.Say("scale"))
+			Expect(outputBuffer).To(test_helpers.Say("remove"))
+		})
+
+		It("limits the output to the most recent entries", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--limit=1"})
+
+			Expect(outputBuffer).ToNot(test_helpers.Say("scale"))
+			Expect(outputBuffer).To(test_helpers.Say("remove"))
+		})
+
+		It("outputs entries as JSON lines", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--json", "--command=scale"})
+
+			var entry audit.Entry
+			Expect(json.Unmarshal(outputBuffer.Contents(), &entry)).To(Succeed())
+			Expect(entry.User).To(Equal("bob"))
+			Expect(entry.App).To(Equal("cool-web-app"))
+		})
+
+		It("tells the user when there are no matching entries", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--app=nope"})
+
+			Expect(outputBuffer).To(test_helpers.Say("No audit entries found."))
+		})
+
+		It("reports errors reading the audit log", func() {
+			auditor.EntriesReturns(nil, errors.New("corrupt"))
+
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error reading audit log: corrupt"))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
+		})
+
+		It("validates the limit", func() {
+			test_helpers.ExecuteCommandWithArgs(auditCommand, []string{"--limit=-1"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+			Expect(auditor.EntriesCallCount()).To(BeZero())
+		})
+	})
+})
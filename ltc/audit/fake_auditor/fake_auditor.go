@@ -0,0 +1,87 @@
+// This file was generated by counterfeiter
+package fake_auditor
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+)
+
+type FakeAuditor struct {
+	RecordStub        func(entry audit.Entry, err error) error
+	recordMutex       sync.RWMutex
+	recordArgsForCall []struct {
+		entry audit.Entry
+		err   error
+	}
+	recordReturns struct {
+		result1 error
+	}
+	EntriesStub        func() ([]audit.Entry, error)
+	entriesMutex       sync.RWMutex
+	entriesArgsForCall []struct{}
+	entriesReturns     struct {
+		result1 []audit.Entry
+		result2 error
+	}
+}
+
+func (fake *FakeAuditor) Record(entry audit.Entry, err error) error {
+	fake.recordMutex.Lock()
+	fake.recordArgsForCall = append(fake.recordArgsForCall, struct {
+		entry audit.Entry
+		err   error
+	}{entry, err})
+	fake.recordMutex.Unlock()
+	if fake.RecordStub != nil {
+		return fake.RecordStub(entry, err)
+	} else {
+		return fake.recordReturns.result1
+	}
+}
+
+func (fake *FakeAuditor) RecordCallCount() int {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	return len(fake.recordArgsForCall)
+}
+
+func (fake *FakeAuditor) RecordArgsForCall(i int) (audit.Entry, error) {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	return fake.recordArgsForCall[i].entry, fake.recordArgsForCall[i].err
+}
+
+func (fake *FakeAuditor) RecordReturns(result1 error) {
+	fake.RecordStub = nil
+	fake.recordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditor) Entries() ([]audit.Entry, error) {
+	fake.entriesMutex.Lock()
+	fake.entriesArgsForCall = append(fake.entriesArgsForCall, struct{}{})
+	fake.entriesMutex.Unlock()
+	if fake.EntriesStub != nil {
+		return fake.EntriesStub()
+	} else {
+		return fake.entriesReturns.result1, fake.entriesReturns.result2
+	}
+}
+
+func (fake *FakeAuditor) EntriesCallCount() int {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return len(fake.entriesArgsForCall)
+}
+
+func (fake *FakeAuditor) EntriesReturns(result1 []audit.Entry, result2 error) {
+	fake.EntriesStub = nil
+	fake.entriesReturns = struct {
+		result1 []audit.Entry
+		result2 error
+	}{result1, result2}
+}
+
+var _ audit.Auditor = new(FakeAuditor)
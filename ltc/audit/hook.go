@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// HookVar is the environment variable holding the audit hook, as taken by
+// NewHook.
+const HookVar = "LATTICE_CLI_AUDIT_HOOK"
+
+// HookTimeout bounds how long Record waits for a hook, as hooks run on every
+// audited command.
+var HookTimeout = 10 * time.Second
+
+type Hook interface {
+	Notify(entry Entry) error
+}
+
+// HookError is returned by Record when the entry was written to the audit
+// log but the hook could not be notified.
+type HookError struct {
+	Err error
+}
+
+func (err *HookError) Error() string {
+	return "audit hook failed: " + err.Err.Error()
+}
+
+// NewHook returns a webhook for http(s) URLs, a shell command hook for anything
+// else, and nil when no hook is configured.
+func NewHook(spec string) Hook {
+	switch {
+	case spec == "":
+		return nil
+	case strings.HasPrefix(spec, "http://"), strings.HasPrefix(spec, "https://"):
+		return &webHook{url: spec, httpClient: &http.Client{Timeout: HookTimeout}}
+	default:
+		return &commandHook{command: spec, timeout: HookTimeout}
+	}
+}
+
+type webHook struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (h *webHook) Notify(entry Entry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.httpClient.Post(h.url, "application/json", bytes.NewReader(entryJSON))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("%s returned %s", h.url, response.Status)
+	}
+	return nil
+}
+
+type commandHook struct {
+	command string
+	timeout time.Duration
+}
+
+func (h *commandHook) Notify(entry Entry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	var output bytes.Buffer
+	cmd := exec.Command("sh", "-c", h.command)
+	cmd.Stdin = bytes.NewReader(append(entryJSON, '\n'))
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("%s: %s", err, strings.TrimSpace(output.String()))
+		}
+		return nil
+	case <-time.After(h.timeout):
+		cmd.Process.Kill()
+		return fmt.Errorf("%s timed out after %s", h.command, h.timeout)
+	}
+}
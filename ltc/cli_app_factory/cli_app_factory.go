@@ -11,10 +11,20 @@ import (
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_metadata_fetcher"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/audited_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/config_helpers"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/target_verifier"
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/cancellable_receptor_client"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exporter"
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
 	"github.com/cloudfoundry-incubator/lattice/ltc/integration_test"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
@@ -25,15 +35,22 @@ import (
 	"github.com/pivotal-golang/clock"
 	"github.com/pivotal-golang/lager"
 
+	api_server_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/api_server/command_factory"
 	app_examiner_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/command_factory"
 	app_runner_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/app_runner/command_factory"
+	audit_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/audit/command_factory"
+	completion_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/completion/command_factory"
 	config_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/config/command_factory"
+	event_streamer_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/event_streamer/command_factory"
+	exporter_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/exporter/command_factory"
+	firehose_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/firehose/command_factory"
 	integration_test_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/integration_test/command_factory"
 	logs_command_factory "github.com/cloudfoundry-incubator/lattice/ltc/logs/command_factory"
 )
 
 var nonTargetVerifiedCommandNames = map[string]struct{}{
-	config_command_factory.TargetCommandName: {},
+	config_command_factory.TargetCommandName:         {},
+	completion_command_factory.CompletionCommandName: {},
 	"help": {},
 }
 
@@ -44,7 +61,7 @@ const (
 	latticeCliHomeVar = "LATTICE_CLI_HOME"
 )
 
-func MakeCliApp(timeoutStr, latticeVersion, ltcConfigRoot string, exitHandler exit_handler.ExitHandler, config *config.Config, logger lager.Logger, targetVerifier target_verifier.TargetVerifier, cliStdout io.Writer) *cli.App {
+func MakeCliApp(timeoutStr, latticeVersion, ltcConfigRoot string, exitHandler exit_handler.ExitHandler, config *config.Config, logger lager.Logger, targetVerifier target_verifier.TargetVerifier, cliStdout, cliStderr io.Writer) *cli.App {
 	config.Load()
 	app := cli.NewApp()
 	app.Name = AppName
@@ -53,9 +70,13 @@ func MakeCliApp(timeoutStr, latticeVersion, ltcConfigRoot string, exitHandler ex
 	app.Usage = LtcUsage
 	app.Email = "lattice@cloudfoundry.org"
 
-	ui := terminal.NewUI(os.Stdin, cliStdout, password_reader.NewPasswordReader(exitHandler))
+	ui := terminal.NewUI(os.Stdin, cliStdout, cliStderr, password_reader.NewPasswordReader(exitHandler))
 
-	app.Commands = cliCommands(timeoutStr, ltcConfigRoot, exitHandler, config, logger, targetVerifier, ui)
+	appNameCache := completion.NewAppNameCache(config_helpers.CompletionCacheFileLocation(ltcConfigRoot), app_examiner.New(receptor.NewClient(config.Receptor())), clock.NewClock())
+	completionCommandFactory := completion_command_factory.NewCompletionCommandFactory(completion.New(appNameCache), ui, exitHandler)
+
+	app.Commands = cliCommands(timeoutStr, ltcConfigRoot, exitHandler, config, logger, targetVerifier, ui, terminal.IsTTY(cliStdout), completionCommandFactory)
+	app.Action = completionCommandFactory.MakeCompleteAction(app.Action)
 
 	app.Before = func(context *cli.Context) error {
 		args := context.Args()
@@ -70,10 +91,12 @@ func MakeCliApp(timeoutStr, latticeVersion, ltcConfigRoot string, exitHandler ex
 		}
 
 		if receptorUp, authorized, err := targetVerifier.VerifyTarget(config.Receptor()); !receptorUp {
-			ui.Say(fmt.Sprintf("Error connecting to the receptor. Make sure your lattice target is set, and that lattice is up and running.\n\tUnderlying error: %s", err.Error()))
+			ui.SayError(fmt.Sprintf("Error connecting to the receptor. Make sure your lattice target is set, and that lattice is up and running.\n\tUnderlying error: %s", err.Error()))
+			exitHandler.Exit(exit_codes.BadTarget)
 			return err
 		} else if !authorized {
-			ui.Say("Could not authenticate with the receptor. Please run ltc target with the correct credentials.")
+			ui.SayError("Could not authenticate with the receptor. Please run ltc target with the correct credentials.")
+			exitHandler.Exit(exit_codes.Unauthorized)
 			return errors.New("Could not authenticate with the receptor.")
 		}
 		return nil
@@ -82,18 +105,27 @@ func MakeCliApp(timeoutStr, latticeVersion, ltcConfigRoot string, exitHandler ex
 	return app
 }
 
-func cliCommands(timeoutStr, ltcConfigRoot string, exitHandler exit_handler.ExitHandler, config *config.Config, logger lager.Logger, targetVerifier target_verifier.TargetVerifier, ui terminal.UI) []cli.Command {
-
-	receptorClient := receptor.NewClient(config.Receptor())
-	appRunner := docker_app_runner.New(receptorClient, config.Target())
+func cliCommands(timeoutStr, ltcConfigRoot string, exitHandler exit_handler.ExitHandler, config *config.Config, logger lager.Logger, targetVerifier target_verifier.TargetVerifier, ui terminal.UI, isTerminal bool, completionCommandFactory *completion_command_factory.CompletionCommandFactory) []cli.Command {
 
 	clock := clock.NewClock()
 
-	logReader := logs.NewLogReader(noaa.NewConsumer(LoggregatorUrl(config.Loggregator()), nil, nil))
-	tailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(ui, logReader)
+	auditor := audit.New(config_helpers.AuditLogFileLocation(ltcConfigRoot), config, audit.NewHook(os.Getenv(audit.HookVar)), clock)
+
+	receptorClient := cancellable_receptor_client.New(receptor.NewClient(config.Receptor()), exitHandler.Cancelled())
+	unauditedAppRunner := docker_app_runner.New(receptorClient, config.Target())
+	appRunner := audited_app_runner.New(unauditedAppRunner, auditor, audit.SourceCLI, func(err error) {
+		ui.SayError(audit.Warning(err))
+	})
+	appExaminer := app_examiner.New(receptorClient)
+
+	logReaderFactory := func() logs.LogReader {
+		return logs.NewLogReader(noaa.NewConsumer(config_helpers.LoggregatorUrl(config.Loggregator()), nil, nil))
+	}
+	tailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(ui, logReaderFactory, clock, exitHandler.Cancelled())
 
 	appRunnerCommandFactoryConfig := app_runner_command_factory.AppRunnerCommandFactoryConfig{
 		AppRunner:             appRunner,
+		AppExaminer:           appExaminer,
 		DockerMetadataFetcher: docker_metadata_fetcher.New(docker_metadata_fetcher.NewDockerSessionFactory()),
 		UI:                  ui,
 		Timeout:             Timeout(timeoutStr),
@@ -107,28 +139,52 @@ func cliCommands(timeoutStr, ltcConfigRoot string, exitHandler exit_handler.Exit
 
 	appRunnerCommandFactory := app_runner_command_factory.NewAppRunnerCommandFactory(appRunnerCommandFactoryConfig)
 
-	logsCommandFactory := logs_command_factory.NewLogsCommandFactory(ui, tailedLogsOutputter, exitHandler)
+	logsCommandFactory := logs_command_factory.NewLogsCommandFactory(appExaminer, ui, tailedLogsOutputter, exitHandler, isTerminal)
 
-	configCommandFactory := config_command_factory.NewConfigCommandFactory(config, ui, targetVerifier, exitHandler)
+	configCommandFactory := config_command_factory.NewConfigCommandFactory(config, ui, targetVerifier, exitHandler, auditor)
 
-	appExaminer := app_examiner.New(receptorClient)
 	appExaminerCommandFactory := app_examiner_command_factory.NewAppExaminerCommandFactory(appExaminer, ui, clock, exitHandler)
 
+	auditCommandFactory := audit_command_factory.NewAuditCommandFactory(auditor, ui, clock, exitHandler)
+
+	eventStreamer := event_streamer.New(receptorClient, clock)
+	eventStreamerCommandFactory := event_streamer_command_factory.NewEventStreamerCommandFactory(eventStreamer, ui, clock, exitHandler)
+
+	metricExporter := exporter.New(appExaminer, noaa.NewConsumer(config_helpers.LoggregatorUrl(config.Loggregator()), nil, nil), clock, logger)
+	exporterCommandFactory := exporter_command_factory.NewExporterCommandFactory(metricExporter, eventStreamer, ui, clock, exitHandler)
+
+	firehoseReader := firehose.NewFirehoseReader(noaa.NewConsumer(config_helpers.LoggregatorUrl(config.Loggregator()), nil, nil))
+	firehoseCommandFactory := firehose_command_factory.NewFirehoseCommandFactory(firehoseReader, ui, clock, exitHandler, isTerminal)
+
+	serveAppRunner := audited_app_runner.New(unauditedAppRunner, auditor, audit.SourceServe, func(err error) {
+		logger.Error("audit-failed", err)
+	})
+	latticeClient := lattice.NewClient(serveAppRunner, appExaminer, logReaderFactory, clock)
+	serveCommandFactory := api_server_command_factory.NewServeCommandFactory(latticeClient, config.Target(), ui, exitHandler, logger)
+
 	testRunner := integration_test.NewIntegrationTestRunner(config, ltcConfigRoot)
 	integrationTestCommandFactory := integration_test_command_factory.NewIntegrationTestCommandFactory(testRunner)
 
 	return []cli.Command{
+		auditCommandFactory.MakeAuditCommand(),
+		appExaminerCommandFactory.MakeCellsCommand(),
+		completionCommandFactory.MakeCompletionCommand(),
 		appRunnerCommandFactory.MakeCreateAppCommand(),
 		logsCommandFactory.MakeDebugLogsCommand(),
+		eventStreamerCommandFactory.MakeEventsCommand(),
+		exporterCommandFactory.MakeExporterCommand(),
+		firehoseCommandFactory.MakeFirehoseCommand(),
 		appExaminerCommandFactory.MakeListAppCommand(),
 		logsCommandFactory.MakeLogsCommand(),
 		appRunnerCommandFactory.MakeRemoveAppCommand(),
 		appRunnerCommandFactory.MakeScaleAppCommand(),
+		serveCommandFactory.MakeServeCommand(),
 		appExaminerCommandFactory.MakeStatusCommand(),
 		configCommandFactory.MakeTargetCommand(),
 		integrationTestCommandFactory.MakeIntegrationTestCommand(),
 		appRunnerCommandFactory.MakeUpdateRoutesCommand(),
 		appExaminerCommandFactory.MakeVisualizeCommand(),
+		appRunnerCommandFactory.MakeWaitCommand(),
 	}
 }
 
@@ -140,10 +196,6 @@ func Timeout(timeoutEnv string) time.Duration {
 	return time.Minute
 }
 
-func LoggregatorUrl(loggregatorTarget string) string {
-	return "ws://" + loggregatorTarget
-}
-
 func defaultVersion(latticeVersion string) string {
 	if latticeVersion == "" {
 		return "development (not versioned)"
@@ -13,6 +13,7 @@ import (
 	"github.com/cloudfoundry-incubator/lattice/ltc/config"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/persister"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/target_verifier/fake_target_verifier"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
@@ -25,6 +26,7 @@ import (
 var _ = Describe("CliAppFactory", func() {
 	var (
 		fakeTargetVerifier *fake_target_verifier.FakeTargetVerifier
+		fakeExitHandler    *fake_exit_handler.FakeExitHandler
 		memPersister       persister.Persister
 		outputBuffer       *gbytes.Buffer
 		terminalUI         terminal.UI
@@ -35,9 +37,10 @@ var _ = Describe("CliAppFactory", func() {
 
 	BeforeEach(func() {
 		fakeTargetVerifier = &fake_target_verifier.FakeTargetVerifier{}
+		fakeExitHandler = &fake_exit_handler.FakeExitHandler{}
 		memPersister = persister.NewMemPersister()
 		outputBuffer = gbytes.NewBuffer()
-		terminalUI = terminal.NewUI(nil, outputBuffer, nil)
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
 		cliConfig = config.New(memPersister)
 		latticeVersion = "v0.2.Test"
 	})
@@ -47,11 +50,12 @@ var _ = Describe("CliAppFactory", func() {
 			"30",
 			latticeVersion,
 			"~/",
-			&fake_exit_handler.FakeExitHandler{},
+			fakeExitHandler,
 			cliConfig,
 			lager.NewLogger("test"),
 			fakeTargetVerifier,
 			terminalUI,
+			terminalUI,
 		)
 	})
 
@@ -181,6 +185,19 @@ var _ = Describe("CliAppFactory", func() {
 				})
 			})
 
+			Context("when running the hidden completion command", func() {
+				It("prints suggestions without verifying the current target", func() {
+					cliConfig.SetTarget("my-lattice.example.com")
+					cliConfig.Save()
+
+					err := cliApp.Run([]string{"ltc", "__complete", "sta"})
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeTargetVerifier.VerifyTargetCallCount()).To(BeZero())
+					Expect(outputBuffer).To(test_helpers.Say("status\n"))
+				})
+			})
+
 			Context("Any other command", func() {
 				Context("when targeted receptor is up and we are authorized", func() {
 					It("executes the command", func() {
@@ -223,6 +240,7 @@ var _ = Describe("CliAppFactory", func() {
 						Expect(fakeTargetVerifier.VerifyTargetCallCount()).To(Equal(1))
 						Expect(fakeTargetVerifier.VerifyTargetArgsForCall(0)).To(Equal("http://receptor.my-borked-lattice.example.com"))
 						Expect(commandRan).To(BeFalse())
+						Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Unauthorized}))
 					})
 				})
 
@@ -245,6 +263,7 @@ var _ = Describe("CliAppFactory", func() {
 						Expect(fakeTargetVerifier.VerifyTargetCallCount()).To(Equal(1))
 						Expect(fakeTargetVerifier.VerifyTargetArgsForCall(0)).To(Equal("http://receptor.my-borked-lattice.example.com"))
 						Expect(commandRan).To(BeFalse())
+						Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.BadTarget}))
 					})
 				})
 			})
@@ -266,11 +285,4 @@ var _ = Describe("CliAppFactory", func() {
 		})
 	})
 
-	Describe("LoggregatorUrl", func() {
-		It("returns loggregator url with the websocket scheme added", func() {
-			loggregatorUrl := cli_app_factory.LoggregatorUrl("doppler.diego.io")
-			Expect(loggregatorUrl).To(Equal("ws://doppler.diego.io"))
-		})
-	})
-
 })
@@ -0,0 +1,92 @@
+package completion
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/pivotal-golang/clock"
+)
+
+// AppNameCacheTTL is how long app names are reused before the receptor is
+// asked again. Completion runs on every tab press, so it has to be quick.
+const AppNameCacheTTL = 30 * time.Second
+
+//go:generate counterfeiter -o fake_app_name_cache/fake_app_name_cache.go . AppNameCache
+type AppNameCache interface {
+	AppNames() ([]string, error)
+}
+
+type cachedAppNames struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	AppNames  []string  `json:"app_names"`
+}
+
+type appNameCache struct {
+	cacheFilePath string
+	appExaminer   app_examiner.AppExaminer
+	clock         clock.Clock
+}
+
+func NewAppNameCache(cacheFilePath string, appExaminer app_examiner.AppExaminer, clock clock.Clock) AppNameCache {
+	return &appNameCache{
+		cacheFilePath: cacheFilePath,
+		appExaminer:   appExaminer,
+		clock:         clock,
+	}
+}
+
+// AppNames returns the cached app names while they are fresh, and otherwise
+// lists the apps again. Stale names are better than none when the receptor
+// cannot be reached.
+func (c *appNameCache) AppNames() ([]string, error) {
+	cached, cacheErr := c.load()
+	if cacheErr == nil && c.clock.Now().Sub(cached.UpdatedAt) < AppNameCacheTTL {
+		return cached.AppNames, nil
+	}
+
+	apps, err := c.appExaminer.ListApps()
+	if err != nil {
+		if cacheErr == nil {
+			return cached.AppNames, nil
+		}
+		return nil, err
+	}
+
+	appNames := []string{}
+	for _, app := range apps {
+		appNames = append(appNames, app.ProcessGuid)
+	}
+
+	c.save(cachedAppNames{UpdatedAt: c.clock.Now(), AppNames: appNames})
+
+	return appNames, nil
+}
+
+func (c *appNameCache) load() (cachedAppNames, error) {
+	var cached cachedAppNames
+
+	contents, err := ioutil.ReadFile(c.cacheFilePath)
+	if err != nil {
+		return cached, err
+	}
+
+	err = json.Unmarshal(contents, &cached)
+	return cached, err
+}
+
+func (c *appNameCache) save(cached cachedAppNames) {
+	contents, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.cacheFilePath), 0700); err != nil {
+		return
+	}
+
+	ioutil.WriteFile(c.cacheFilePath, contents, 0600)
+}
@@ -0,0 +1,90 @@
+package completion_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+var _ = Describe("AppNameCache", func() {
+	var (
+		appExaminer   *fake_app_examiner.FakeAppExaminer
+		clock         *fakeclock.FakeClock
+		tmpDir        string
+		cacheFilePath string
+		appNameCache  completion.AppNameCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "completion")
+		Expect(err).ToNot(HaveOccurred())
+
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app"}, {ProcessGuid: "other-app"}}, nil)
+		clock = fakeclock.NewFakeClock(time.Now())
+		cacheFilePath = filepath.Join(tmpDir, ".lattice", "completion_cache.json")
+
+		appNameCache = completion.NewAppNameCache(cacheFilePath, appExaminer, clock)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("lists the apps and caches their names", func() {
+		appNames, err := appNameCache.AppNames()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appNames).To(Equal([]string{"cool-web-app", "other-app"}))
+		_, err = os.Stat(cacheFilePath)
+		Expect(err).ToNot(HaveOccurred())
+
+		appNames, err = appNameCache.AppNames()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appNames).To(Equal([]string{"cool-web-app", "other-app"}))
+		Expect(appExaminer.ListAppsCallCount()).To(Equal(1))
+	})
+
+	It("lists the apps again once the cache has expired", func() {
+		_, err := appNameCache.AppNames()
+		Expect(err).ToNot(HaveOccurred())
+
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "new-app"}}, nil)
+		clock.Increment(completion.AppNameCacheTTL)
+
+		appNames, err := appNameCache.AppNames()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appNames).To(Equal([]string{"new-app"}))
+		Expect(appExaminer.ListAppsCallCount()).To(Equal(2))
+	})
+
+	Context("when listing the apps fails", func() {
+		BeforeEach(func() {
+			appExaminer.ListAppsReturns(nil, errors.New("receptor down"))
+		})
+
+		It("returns the error without a cache", func() {
+			_, err := appNameCache.AppNames()
+			Expect(err).To(MatchError("receptor down"))
+		})
+
+		It("falls back to the stale cache", func() {
+			Expect(os.MkdirAll(filepath.Dir(cacheFilePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(cacheFilePath, []byte(`{"updated_at":"2015-01-01T00:00:00Z","app_names":["old-app"]}`), 0600)).To(Succeed())
+
+			appNames, err := appNameCache.AppNames()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(appNames).To(Equal([]string{"old-app"}))
+		})
+	})
+})
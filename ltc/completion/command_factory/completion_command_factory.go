@@ -0,0 +1,70 @@
+package command_factory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/codegangsta/cli"
+)
+
+const CompletionCommandName = "completion"
+
+type CompletionCommandFactory struct {
+	completer   completion.Completer
+	ui          terminal.UI
+	exitHandler exit_handler.ExitHandler
+}
+
+func NewCompletionCommandFactory(completer completion.Completer, ui terminal.UI, exitHandler exit_handler.ExitHandler) *CompletionCommandFactory {
+	return &CompletionCommandFactory{completer, ui, exitHandler}
+}
+
+func (factory *CompletionCommandFactory) MakeCompletionCommand() cli.Command {
+	shells := strings.Join(completion.Shells(), "|")
+
+	return cli.Command{
+		Name:        CompletionCommandName,
+		ShortName:   "co",
+		Usage:       "Generates a shell completion script (e.g., source <(ltc completion bash))",
+		Description: "ltc completion " + shells,
+		Action:      factory.printCompletionScript,
+	}
+}
+
+// MakeCompleteAction returns the app's default action, answering the hidden
+// completion command the generated scripts call and otherwise running
+// defaultAction. It is not a registered command so it stays out of help.
+func (factory *CompletionCommandFactory) MakeCompleteAction(defaultAction func(*cli.Context)) func(*cli.Context) {
+	return func(context *cli.Context) {
+		if context.Args().First() != completion.CompleteCommandName {
+			defaultAction(context)
+			return
+		}
+
+		for _, suggestion := range factory.completer.Complete(context.App.Commands, context.Args().Tail()) {
+			factory.ui.SayLine(suggestion)
+		}
+	}
+}
+
+func (factory *CompletionCommandFactory) printCompletionScript(context *cli.Context) {
+	shell := context.Args().First()
+	if shell == "" {
+		factory.ui.IncorrectUsage("Please specify a shell: " + strings.Join(completion.Shells(), ", "))
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	script, err := completion.Script(shell)
+	if err != nil {
+		factory.ui.IncorrectUsage(fmt.Sprintf("%s. Supported shells are: %s", err, strings.Join(completion.Shells(), ", ")))
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	factory.ui.Say(script)
+}
@@ -0,0 +1,103 @@
+package command_factory_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion/fake_completer"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/codegangsta/cli"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		completer       *fake_completer.FakeCompleter
+		outputBuffer    *gbytes.Buffer
+		fakeExitHandler *fake_exit_handler.FakeExitHandler
+		commandFactory  *command_factory.CompletionCommandFactory
+	)
+
+	BeforeEach(func() {
+		completer = &fake_completer.FakeCompleter{}
+		outputBuffer = gbytes.NewBuffer()
+		fakeExitHandler = &fake_exit_handler.FakeExitHandler{}
+		commandFactory = command_factory.NewCompletionCommandFactory(completer, terminal.NewUI(nil, outputBuffer, outputBuffer, nil), fakeExitHandler)
+	})
+
+	Describe("CompletionCommand", func() {
+		var completionCommand cli.Command
+
+		BeforeEach(func() {
+			completionCommand = commandFactory.MakeCompletionCommand()
+		})
+
+		It("prints the completion script for the shell", func() {
+			test_helpers.ExecuteCommandWithArgs(completionCommand, []string{"bash"})
+
+			script, err := completion.Script("bash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputBuffer.Contents()).To(Equal([]byte(script)))
+		})
+
+		It("requires a shell", func() {
+			test_helpers.ExecuteCommandWithArgs(completionCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Please specify a shell: bash, fish, zsh"))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("rejects unsupported shells", func() {
+			test_helpers.ExecuteCommandWithArgs(completionCommand, []string{"tcsh"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Unsupported shell: tcsh. Supported shells are: bash, fish, zsh"))
+			Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+	})
+
+	Describe("CompleteAction", func() {
+		var (
+			app              *cli.App
+			defaultActionRan bool
+		)
+
+		BeforeEach(func() {
+			defaultActionRan = false
+
+			app = cli.NewApp()
+			app.Writer = outputBuffer
+			app.Commands = []cli.Command{{Name: "status"}}
+			app.Action = commandFactory.MakeCompleteAction(func(*cli.Context) {
+				defaultActionRan = true
+			})
+		})
+
+		It("prints the suggestions for the words after the hidden command", func() {
+			completer.CompleteReturns([]string{"cool-web-app", "cooler-web-app"})
+
+			Expect(app.Run([]string{"ltc", completion.CompleteCommandName, "status", "cool"})).To(Succeed())
+
+			Expect(completer.CompleteCallCount()).To(Equal(1))
+			commands, words := completer.CompleteArgsForCall(0)
+			Expect(commands[0].Name).To(Equal("status"))
+			Expect(words).To(Equal([]string{"status", "cool"}))
+
+			Expect(outputBuffer).To(test_helpers.Say("cool-web-app\ncooler-web-app\n"))
+			Expect(defaultActionRan).To(BeFalse())
+		})
+
+		It("runs the default action otherwise", func() {
+			Expect(app.Run([]string{"ltc"})).To(Succeed())
+
+			Expect(defaultActionRan).To(BeTrue())
+			Expect(completer.CompleteCallCount()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,209 @@
+package completion
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/codegangsta/cli"
+)
+
+// CompleteCommandName is the hidden command the shell scripts call with the
+// words typed so far, the last of which is the word being completed.
+const CompleteCommandName = "__complete"
+
+// appNameCommands take the name of an app as their first argument, or as
+// every argument when they take several apps.
+var appNameCommands = map[string]bool{
+	"logs":          true,
+	"remove":        false,
+	"scale":         false,
+	"status":        false,
+	"update-routes": false,
+	"wait":          false,
+}
+
+// appNameFlag is the flag commands use to filter their output to one app.
+const appNameFlag = "app"
+
+//go:generate counterfeiter -o fake_completer/fake_completer.go . Completer
+type Completer interface {
+	Complete(commands []cli.Command, words []string) []string
+}
+
+type completer struct {
+	appNameCache AppNameCache
+}
+
+func New(appNameCache AppNameCache) Completer {
+	return &completer{appNameCache}
+}
+
+func (c *completer) Complete(commands []cli.Command, words []string) []string {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	current := words[len(words)-1]
+
+	if len(words) == 1 {
+		return matching(commandNames(commands), current)
+	}
+
+	command := findCommand(commands, words[0])
+	if command == nil {
+		return nil
+	}
+	if command.Name == "help" {
+		if len(words) == 2 {
+			return matching(commandNames(commands), current)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(current, "-") {
+		return matching(flagNames(command.Flags), current)
+	}
+
+	if previous := words[len(words)-2]; strings.HasPrefix(previous, "-") && !strings.Contains(previous, "=") {
+		if flag := findFlag(command.Flags, previous); flag != nil && takesValue(flag) {
+			if canonicalName(flag) == appNameFlag {
+				return matching(c.appNames(), current)
+			}
+			return nil
+		}
+	}
+
+	if severalApps, ok := appNameCommands[command.Name]; ok && (severalApps || positionalIndex(command.Flags, words[1:len(words)-1]) == 0) {
+		return matching(c.appNames(), current)
+	}
+
+	return nil
+}
+
+func (c *completer) appNames() []string {
+	appNames, err := c.appNameCache.AppNames()
+	if err != nil {
+		return nil
+	}
+	return appNames
+}
+
+func commandNames(commands []cli.Command) []string {
+	names := []string{}
+	for _, command := range commands {
+		if command.Name == CompleteCommandName {
+			continue
+		}
+		names = append(names, command.Name)
+	}
+	if findCommand(commands, "help") == nil {
+		names = append(names, "help")
+	}
+	return names
+}
+
+func findCommand(commands []cli.Command, name string) *cli.Command {
+	if name == "help" || name == "h" {
+		return &cli.Command{Name: "help"}
+	}
+	for _, command := range commands {
+		if command.HasName(name) {
+			return &command
+		}
+	}
+	return nil
+}
+
+// positionalIndex counts the arguments that are not flags or flag values.
+func positionalIndex(flags []cli.Flag, words []string) int {
+	index := 0
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if !strings.HasPrefix(word, "-") {
+			index++
+			continue
+		}
+		if flag := findFlag(flags, word); flag != nil && takesValue(flag) && !strings.Contains(word, "=") {
+			i++
+		}
+	}
+	return index
+}
+
+func flagNames(flags []cli.Flag) []string {
+	names := []string{}
+	for _, flag := range flags {
+		for _, name := range aliases(flag) {
+			if len(name) == 1 {
+				names = append(names, "-"+name)
+			} else {
+				names = append(names, "--"+name)
+			}
+		}
+	}
+	return names
+}
+
+func findFlag(flags []cli.Flag, word string) cli.Flag {
+	name := strings.TrimLeft(strings.SplitN(word, "=", 2)[0], "-")
+	for _, flag := range flags {
+		for _, alias := range aliases(flag) {
+			if alias == name {
+				return flag
+			}
+		}
+	}
+	return nil
+}
+
+func takesValue(flag cli.Flag) bool {
+	switch flag.(type) {
+	case cli.BoolFlag, cli.BoolTFlag:
+		return false
+	}
+	return true
+}
+
+func canonicalName(flag cli.Flag) string {
+	return aliases(flag)[0]
+}
+
+func aliases(flag cli.Flag) []string {
+	var names string
+	switch f := flag.(type) {
+	case cli.BoolFlag:
+		names = f.Name
+	case cli.BoolTFlag:
+		names = f.Name
+	case cli.StringFlag:
+		names = f.Name
+	case cli.StringSliceFlag:
+		names = f.Name
+	case cli.IntFlag:
+		names = f.Name
+	case cli.IntSliceFlag:
+		names = f.Name
+	case cli.DurationFlag:
+		names = f.Name
+	case cli.Float64Flag:
+		names = f.Name
+	case cli.GenericFlag:
+		names = f.Name
+	}
+
+	aliases := []string{}
+	for _, name := range strings.Split(names, ",") {
+		aliases = append(aliases, strings.TrimSpace(name))
+	}
+	return aliases
+}
+
+func matching(candidates []string, prefix string) []string {
+	matches := []string{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			matches = append(matches, candidate)
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
@@ -0,0 +1,147 @@
+package completion_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion/fake_app_name_cache"
+	"github.com/codegangsta/cli"
+)
+
+var _ = Describe("Completer", func() {
+	var (
+		appNameCache *fake_app_name_cache.FakeAppNameCache
+		completer    completion.Completer
+		commands     []cli.Command
+	)
+
+	BeforeEach(func() {
+		appNameCache = &fake_app_name_cache.FakeAppNameCache{}
+		appNameCache.AppNamesReturns([]string{"cool-web-app", "cooler-web-app", "other-app"}, nil)
+		completer = completion.New(appNameCache)
+
+		commands = []cli.Command{
+			{
+				Name:      "audit",
+				ShortName: "au",
+				Flags: []cli.Flag{
+					cli.StringFlag{Name: "app, a"},
+					cli.IntFlag{Name: "limit, n"},
+					cli.BoolFlag{Name: "json, j"},
+				},
+			},
+			{Name: "create", ShortName: "cr"},
+			{
+				Name:      "logs",
+				ShortName: "lo",
+				Flags: []cli.Flag{
+					cli.StringSliceFlag{Name: "source, s"},
+				},
+			},
+			{Name: "scale", ShortName: "sc"},
+			{
+				Name:      "status",
+				ShortName: "st",
+				Flags: []cli.Flag{
+					cli.BoolFlag{Name: "json, j"},
+					cli.DurationFlag{Name: "rate, r"},
+				},
+			},
+			{Name: "wait"},
+			{Name: "help", ShortName: "h"},
+		}
+	})
+
+	Context("when completing the command", func() {
+		It("suggests the matching command names", func() {
+			Expect(completer.Complete(commands, []string{"s"})).To(Equal([]string{"scale", "status"}))
+		})
+
+		It("suggests every command for an empty word", func() {
+			Expect(completer.Complete(commands, []string{""})).To(Equal([]string{"audit", "create", "help", "logs", "scale", "status", "wait"}))
+			Expect(completer.Complete(commands, []string{})).To(Equal([]string{"audit", "create", "help", "logs", "scale", "status", "wait"}))
+		})
+
+		It("suggests command names after help", func() {
+			Expect(completer.Complete(commands, []string{"help", "c"})).To(Equal([]string{"create"}))
+		})
+	})
+
+	Context("when completing a flag", func() {
+		It("suggests the matching flags of the command", func() {
+			Expect(completer.Complete(commands, []string{"status", "cool-web-app", "--"})).To(Equal([]string{"--json", "--rate"}))
+			Expect(completer.Complete(commands, []string{"st", "-"})).To(Equal([]string{"--json", "--rate", "-j", "-r"}))
+		})
+
+		It("suggests nothing for unknown commands", func() {
+			Expect(completer.Complete(commands, []string{"buy-me-a-pony", "--"})).To(BeEmpty())
+		})
+	})
+
+	Context("when completing an app name argument", func() {
+		It("suggests the matching app names", func() {
+			Expect(completer.Complete(commands, []string{"scale", "cool"})).To(Equal([]string{"cool-web-app", "cooler-web-app"}))
+			Expect(completer.Complete(commands, []string{"st", "--json", ""})).To(Equal([]string{"cool-web-app", "cooler-web-app", "other-app"}))
+		})
+
+		It("skips over flag values", func() {
+			Expect(completer.Complete(commands, []string{"status", "--rate", "1s", "o"})).To(Equal([]string{"other-app"}))
+		})
+
+		It("only completes the first argument", func() {
+			Expect(completer.Complete(commands, []string{"scale", "cool-web-app", ""})).To(BeEmpty())
+			Expect(completer.Complete(commands, []string{"wait", "cool-web-app", ""})).To(BeEmpty())
+		})
+
+		It("completes the app to wait for", func() {
+			Expect(completer.Complete(commands, []string{"wait", "o"})).To(Equal([]string{"other-app"}))
+		})
+
+		It("completes every argument of commands taking several apps", func() {
+			Expect(completer.Complete(commands, []string{"logs", "c"})).To(Equal([]string{"cool-web-app", "cooler-web-app"}))
+			Expect(completer.Complete(commands, []string{"lo", "cool-web-app", "--source", "APP", "o"})).To(Equal([]string{"other-app"}))
+		})
+
+		It("does not complete app names for other commands", func() {
+			Expect(completer.Complete(commands, []string{"create", ""})).To(BeEmpty())
+			Expect(appNameCache.AppNamesCallCount()).To(BeZero())
+		})
+
+		It("suggests nothing when the app names cannot be fetched", func() {
+			appNameCache.AppNamesReturns(nil, errors.New("receptor down"))
+
+			Expect(completer.Complete(commands, []string{"scale", ""})).To(BeEmpty())
+		})
+	})
+
+	Context("when completing a flag value", func() {
+		It("suggests app names for the app flag", func() {
+			Expect(completer.Complete(commands, []string{"audit", "-a", "oth"})).To(Equal([]string{"other-app"}))
+			Expect(completer.Complete(commands, []string{"audit", "--app", ""})).To(HaveLen(3))
+		})
+
+		It("suggests nothing for other flags", func() {
+			Expect(completer.Complete(commands, []string{"audit", "--limit", ""})).To(BeEmpty())
+		})
+	})
+
+	Describe("Script", func() {
+		It("returns a script for each supported shell calling the hidden command", func() {
+			Expect(completion.Shells()).To(Equal([]string{"bash", "fish", "zsh"}))
+
+			for _, shell := range completion.Shells() {
+				script, err := completion.Script(shell)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(script).To(ContainSubstring("ltc " + completion.CompleteCommandName))
+			}
+		})
+
+		It("returns an error for unsupported shells", func() {
+			_, err := completion.Script("tcsh")
+			Expect(err).To(MatchError("Unsupported shell: tcsh"))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+// This file was generated by counterfeiter
+package fake_app_name_cache
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+)
+
+type FakeAppNameCache struct {
+	AppNamesStub        func() ([]string, error)
+	appNamesMutex       sync.RWMutex
+	appNamesArgsForCall []struct{}
+	appNamesReturns     struct {
+		result1 []string
+		result2 error
+	}
+}
+
+func (fake *FakeAppNameCache) AppNames() ([]string, error) {
+	fake.appNamesMutex.Lock()
+	fake.appNamesArgsForCall = append(fake.appNamesArgsForCall, struct{}{})
+	fake.appNamesMutex.Unlock()
+	if fake.AppNamesStub != nil {
+		return fake.AppNamesStub()
+	} else {
+		return fake.appNamesReturns.result1, fake.appNamesReturns.result2
+	}
+}
+
+func (fake *FakeAppNameCache) AppNamesCallCount() int {
+	fake.appNamesMutex.RLock()
+	defer fake.appNamesMutex.RUnlock()
+	return len(fake.appNamesArgsForCall)
+}
+
+func (fake *FakeAppNameCache) AppNamesReturns(result1 []string, result2 error) {
+	fake.AppNamesStub = nil
+	fake.appNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+var _ completion.AppNameCache = new(FakeAppNameCache)
@@ -0,0 +1,56 @@
+// This file was generated by counterfeiter
+package fake_completer
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/completion"
+	"github.com/codegangsta/cli"
+)
+
+type FakeCompleter struct {
+	CompleteStub        func(commands []cli.Command, words []string) []string
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		commands []cli.Command
+		words    []string
+	}
+	completeReturns struct {
+		result1 []string
+	}
+}
+
+func (fake *FakeCompleter) Complete(commands []cli.Command, words []string) []string {
+	fake.completeMutex.Lock()
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		commands []cli.Command
+		words    []string
+	}{commands, words})
+	fake.completeMutex.Unlock()
+	if fake.CompleteStub != nil {
+		return fake.CompleteStub(commands, words)
+	} else {
+		return fake.completeReturns.result1
+	}
+}
+
+func (fake *FakeCompleter) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeCompleter) CompleteArgsForCall(i int) ([]cli.Command, []string) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return fake.completeArgsForCall[i].commands, fake.completeArgsForCall[i].words
+}
+
+func (fake *FakeCompleter) CompleteReturns(result1 []string) {
+	fake.CompleteStub = nil
+	fake.completeReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+var _ completion.Completer = new(FakeCompleter)
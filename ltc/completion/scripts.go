@@ -0,0 +1,57 @@
+package completion
+
+import (
+	"fmt"
+	"sort"
+)
+
+var scripts = map[string]string{
+	"bash": `_ltc_complete() {
+    local words
+    words=("${COMP_WORDS[@]:1:$COMP_CWORD}")
+    COMPREPLY=($(ltc ` + CompleteCommandName + ` "${words[@]}" 2>/dev/null))
+}
+
+complete -F _ltc_complete ltc
+`,
+
+	"zsh": `#compdef ltc
+
+_ltc() {
+    local -a suggestions
+    suggestions=(${(f)"$(ltc ` + CompleteCommandName + ` "${(@)words[2,$CURRENT]}" 2>/dev/null)"})
+    compadd -a suggestions
+}
+
+compdef _ltc ltc
+`,
+
+	"fish": `function __ltc_complete
+    set -l words (commandline -opc)
+    set -e words[1]
+    set -l current (commandline -ct)
+    ltc ` + CompleteCommandName + ` $words "$current" 2>/dev/null | grep -v '^$'
+end
+
+complete -c ltc -f -a '(__ltc_complete)'
+`,
+}
+
+// Shells lists the shells Script can generate completion for.
+func Shells() []string {
+	shells := []string{}
+	for shell := range scripts {
+		shells = append(shells, shell)
+	}
+	sort.Strings(shells)
+	return shells
+}
+
+// Script returns the completion script to source in the given shell.
+func Script(shell string) (string, error) {
+	script, ok := scripts[shell]
+	if !ok {
+		return "", fmt.Errorf("Unsupported shell: %s", shell)
+	}
+	return script, nil
+}
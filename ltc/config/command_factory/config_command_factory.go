@@ -1,8 +1,10 @@
 package command_factory
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/target_verifier"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
@@ -18,10 +20,11 @@ type ConfigCommandFactory struct {
 	ui             terminal.UI
 	targetVerifier target_verifier.TargetVerifier
 	exitHandler    exit_handler.ExitHandler
+	auditor        audit.Auditor
 }
 
-func NewConfigCommandFactory(config *config.Config, ui terminal.UI, targetVerifier target_verifier.TargetVerifier, exitHandler exit_handler.ExitHandler) *ConfigCommandFactory {
-	return &ConfigCommandFactory{config, ui, targetVerifier, exitHandler}
+func NewConfigCommandFactory(config *config.Config, ui terminal.UI, targetVerifier target_verifier.TargetVerifier, exitHandler exit_handler.ExitHandler, auditor audit.Auditor) *ConfigCommandFactory {
+	return &ConfigCommandFactory{config, ui, targetVerifier, exitHandler, auditor}
 }
 
 func (factory *ConfigCommandFactory) MakeTargetCommand() cli.Command {
@@ -48,11 +51,12 @@ func (factory *ConfigCommandFactory) target(context *cli.Context) {
 	factory.config.SetLogin("", "")
 
 	if _, authorized, err := factory.targetVerifier.VerifyTarget(factory.config.Receptor()); err != nil {
-		factory.ui.Say("Error verifying target: " + err.Error())
+		factory.recordAudit(context, err)
+		factory.ui.SayError("Error verifying target: " + err.Error())
 		factory.exitHandler.Exit(exit_codes.BadTarget)
 		return
 	} else if authorized {
-		factory.save()
+		factory.save(context)
 		return
 	}
 
@@ -61,28 +65,44 @@ func (factory *ConfigCommandFactory) target(context *cli.Context) {
 
 	factory.config.SetLogin(username, password)
 	if _, authorized, err := factory.targetVerifier.VerifyTarget(factory.config.Receptor()); err != nil {
-		factory.ui.Say("Error verifying target: " + err.Error())
+		factory.recordAudit(context, err)
+		factory.ui.SayError("Error verifying target: " + err.Error())
 		factory.exitHandler.Exit(exit_codes.BadTarget)
 		return
 	} else if !authorized {
-		factory.ui.Say("Could not authorize target.")
-		factory.exitHandler.Exit(exit_codes.BadTarget)
+		factory.recordAudit(context, errors.New("could not authorize target"))
+		factory.ui.SayError("Could not authorize target.")
+		factory.exitHandler.Exit(exit_codes.Unauthorized)
 		return
 	}
 
-	factory.save()
+	factory.save(context)
 }
 
-func (factory *ConfigCommandFactory) save() {
+func (factory *ConfigCommandFactory) save(context *cli.Context) {
 	err := factory.config.Save()
+	factory.recordAudit(context, err)
 	if err != nil {
-		factory.ui.Say(err.Error())
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_codes.GeneralError)
 		return
 	}
 
 	factory.ui.Say("Api Location Set")
 }
 
+func (factory *ConfigCommandFactory) recordAudit(context *cli.Context, err error) {
+	entry := audit.Entry{
+		Source:    audit.SourceCLI,
+		Command:   context.Command.Name,
+		Arguments: context.Args(),
+	}
+
+	if auditErr := factory.auditor.Record(entry, err); auditErr != nil {
+		factory.ui.SayError(audit.Warning(auditErr))
+	}
+}
+
 func (factory *ConfigCommandFactory) printTarget() {
 	if factory.config.Target() == "" {
 		factory.ui.Say("Target not set.")
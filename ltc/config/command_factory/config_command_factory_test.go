@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/fake_auditor"
 	config_package "github.com/cloudfoundry-incubator/lattice/ltc/config"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/command_factory"
 	"github.com/cloudfoundry-incubator/lattice/ltc/config/persister"
@@ -31,6 +33,7 @@ var _ = Describe("CommandFactory", func() {
 		fakeTargetVerifier *fake_target_verifier.FakeTargetVerifier
 		fakeExitHandler    *fake_exit_handler.FakeExitHandler
 		fakePasswordReader *fake_password_reader.FakePasswordReader
+		fakeAuditor        *fake_auditor.FakeAuditor
 	)
 
 	BeforeEach(func() {
@@ -38,9 +41,10 @@ var _ = Describe("CommandFactory", func() {
 		outputBuffer = gbytes.NewBuffer()
 		fakeExitHandler = &fake_exit_handler.FakeExitHandler{}
 		fakePasswordReader = &fake_password_reader.FakePasswordReader{}
-		terminalUI = terminal.NewUI(stdinReader, outputBuffer, fakePasswordReader)
+		terminalUI = terminal.NewUI(stdinReader, outputBuffer, outputBuffer, fakePasswordReader)
 		fakeTargetVerifier = &fake_target_verifier.FakeTargetVerifier{}
 		config = config_package.New(persister.NewMemPersister())
+		fakeAuditor = &fake_auditor.FakeAuditor{}
 	})
 
 	Describe("TargetCommand", func() {
@@ -50,7 +54,7 @@ var _ = Describe("CommandFactory", func() {
 		}
 
 		BeforeEach(func() {
-			commandFactory := command_factory.NewConfigCommandFactory(config, terminalUI, fakeTargetVerifier, fakeExitHandler)
+			commandFactory := command_factory.NewConfigCommandFactory(config, terminalUI, fakeTargetVerifier, fakeExitHandler, fakeAuditor)
 			targetCommand = commandFactory.MakeTargetCommand()
 		})
 
@@ -100,6 +104,17 @@ var _ = Describe("CommandFactory", func() {
 				Expect(config.Receptor()).To(Equal("http://receptor.myapi.com"))
 			})
 
+			It("records the new target in the audit log", func() {
+				test_helpers.ExecuteCommandWithArgs(targetCommand, []string{"myapi.com"})
+
+				Expect(fakeAuditor.RecordCallCount()).To(Equal(1))
+				entry, err := fakeAuditor.RecordArgsForCall(0)
+				Expect(entry.Source).To(Equal(audit.SourceCLI))
+				Expect(entry.Command).To(Equal("target"))
+				Expect(entry.Arguments).To(Equal([]string{"myapi.com"}))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
 			It("clears out existing saved target credentials", func() {
 				test_helpers.ExecuteCommandWithArgs(targetCommand, []string{"myapi.com"})
 
@@ -109,7 +124,7 @@ var _ = Describe("CommandFactory", func() {
 
 			Context("when the persister returns errors", func() {
 				BeforeEach(func() {
-					commandFactory := command_factory.NewConfigCommandFactory(config_package.New(errorPersister("FAILURE setting api")), terminalUI, fakeTargetVerifier, fakeExitHandler)
+					commandFactory := command_factory.NewConfigCommandFactory(config_package.New(errorPersister("FAILURE setting api")), terminalUI, fakeTargetVerifier, fakeExitHandler, fakeAuditor)
 					targetCommand = commandFactory.MakeTargetCommand()
 				})
 
@@ -156,7 +171,6 @@ var _ = Describe("CommandFactory", func() {
 
 				AfterEach(func() {
 					verifyOldTargetStillSet()
-					Expect(fakeExitHandler.ExitCalledWith[0]).To(Equal(exit_codes.BadTarget))
 				})
 
 				It("does not save the config if the receptor is never authorized", func() {
@@ -171,6 +185,11 @@ var _ = Describe("CommandFactory", func() {
 					Expect(fakePasswordReader.PromptForPasswordArgsForCall(0)).To(Equal("Password: "))
 
 					Expect(outputBuffer).To(test_helpers.Say("Could not authorize target."))
+					Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.Unauthorized}))
+
+					Expect(fakeAuditor.RecordCallCount()).To(Equal(1))
+					_, err := fakeAuditor.RecordArgsForCall(0)
+					Expect(err).To(MatchError("could not authorize target"))
 				})
 
 				It("does not save the config if there is an error connecting to the receptor after prompting", func() {
@@ -186,6 +205,7 @@ var _ = Describe("CommandFactory", func() {
 					Expect(fakePasswordReader.PromptForPasswordArgsForCall(0)).To(Equal("Password: "))
 
 					Expect(outputBuffer).To(test_helpers.Say("Error verifying target: Unknown Error"))
+					Expect(fakeExitHandler.ExitCalledWith).To(Equal([]int{exit_codes.BadTarget}))
 				})
 			})
 		})
@@ -8,3 +8,17 @@ func ConfigFileLocation(homeDir string) string {
 	configDir := filepath.Join(homeDir, ".lattice")
 	return filepath.Join(configDir, "config.json")
 }
+
+func AuditLogFileLocation(homeDir string) string {
+	configDir := filepath.Join(homeDir, ".lattice")
+	return filepath.Join(configDir, "audit.log")
+}
+
+func CompletionCacheFileLocation(homeDir string) string {
+	configDir := filepath.Join(homeDir, ".lattice")
+	return filepath.Join(configDir, "completion_cache.json")
+}
+
+func LoggregatorUrl(loggregatorTarget string) string {
+	return "ws://" + loggregatorTarget
+}
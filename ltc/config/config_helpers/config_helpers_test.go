@@ -15,4 +15,25 @@ var _ = Describe("ConfigHelpers", func() {
 			Expect(fileLocation).To(Equal("/home/chicago/.lattice/config.json"))
 		})
 	})
+
+	Describe("AuditLogFileLocation", func() {
+		It("returns the audit log location for the diego home path", func() {
+			fileLocation := config_helpers.AuditLogFileLocation("/home/chicago")
+			Expect(fileLocation).To(Equal("/home/chicago/.lattice/audit.log"))
+		})
+	})
+
+	Describe("CompletionCacheFileLocation", func() {
+		It("returns the completion cache location for the diego home path", func() {
+			fileLocation := config_helpers.CompletionCacheFileLocation("/home/chicago")
+			Expect(fileLocation).To(Equal("/home/chicago/.lattice/completion_cache.json"))
+		})
+	})
+
+	Describe("LoggregatorUrl", func() {
+		It("returns loggregator url with the websocket scheme added", func() {
+			loggregatorUrl := config_helpers.LoggregatorUrl("doppler.diego.io")
+			Expect(loggregatorUrl).To(Equal("ws://doppler.diego.io"))
+		})
+	})
 })
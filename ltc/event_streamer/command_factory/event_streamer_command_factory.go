@@ -0,0 +1,204 @@
+package command_factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/command_factory/presentation"
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock"
+)
+
+const TimestampDisplayLayout = "15:04:05"
+
+type EventStreamerCommandFactory struct {
+	eventStreamer event_streamer.EventStreamer
+	ui            terminal.UI
+	clock         clock.Clock
+	exitHandler   exit_handler.ExitHandler
+}
+
+type jsonEvent struct {
+	Timestamp string             `json:"timestamp"`
+	Type      receptor.EventType `json:"type"`
+	Event     receptor.Event     `json:"event"`
+}
+
+func NewEventStreamerCommandFactory(eventStreamer event_streamer.EventStreamer, ui terminal.UI, clock clock.Clock, exitHandler exit_handler.ExitHandler) *EventStreamerCommandFactory {
+	return &EventStreamerCommandFactory{eventStreamer, ui, clock, exitHandler}
+}
+
+func (factory *EventStreamerCommandFactory) MakeEventsCommand() cli.Command {
+	var eventsFlags = []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "app, a",
+			Usage: "Only show events for the given app (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "type, t",
+			Usage: "Only show events of the given type, e.g. actual_lrp_changed (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "domain, d",
+			Usage: "Only show events for LRPs in the given domain (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.BoolFlag{
+			Name:  "json, j",
+			Usage: "Outputs one JSON object per event",
+		},
+	}
+
+	return cli.Command{
+		Name:      "events",
+		ShortName: "ev",
+		Usage:     "Streams DesiredLRP and ActualLRP events from the lattice cluster",
+		Description: `ltc events [--app=APP_NAME] [--type=EVENT_TYPE] [--domain=DOMAIN] [--json]
+
+   EVENT_TYPE is one of: ` + strings.Join(eventTypeNames(), ", "),
+		Action: factory.streamEvents,
+		Flags:  eventsFlags,
+	}
+}
+
+func (factory *EventStreamerCommandFactory) streamEvents(context *cli.Context) {
+	eventTypes := []receptor.EventType{}
+	for _, eventType := range context.StringSlice("type") {
+		if !event_streamer.IsValidEventType(eventType) {
+			factory.ui.IncorrectUsage("Unknown event type: " + eventType)
+			factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+			return
+		}
+		eventTypes = append(eventTypes, receptor.EventType(eventType))
+	}
+
+	filter := event_streamer.EventFilter{
+		AppNames:   context.StringSlice("app"),
+		EventTypes: eventTypes,
+		Domains:    context.StringSlice("domain"),
+	}
+	outputJSON := context.Bool("json")
+
+	factory.exitHandler.OnExit(factory.eventStreamer.StopStreaming)
+
+	factory.eventStreamer.StreamEvents(func(event receptor.Event) {
+		if !filter.Matches(event) {
+			return
+		}
+
+		if outputJSON {
+			factory.sayJSONEvent(event)
+		} else {
+			factory.ui.SayLine(colors.Cyan(factory.clock.Now().Format(TimestampDisplayLayout)) + " " + formatEvent(event))
+		}
+	}, func(err error) {
+		factory.ui.SayError(colors.Red(fmt.Sprintf("Event stream error: %s. Reconnecting...", err)))
+	})
+}
+
+func (factory *EventStreamerCommandFactory) sayJSONEvent(event receptor.Event) {
+	jsonBytes, err := json.Marshal(jsonEvent{
+		Timestamp: factory.clock.Now().Format(time.RFC3339Nano),
+		Type:      event.EventType(),
+		Event:     event,
+	})
+	if err != nil {
+		return
+	}
+
+	factory.ui.SayLine(string(jsonBytes))
+}
+
+func formatEvent(event receptor.Event) string {
+	eventType := colors.Bold(string(event.EventType()))
+
+	switch event := event.(type) {
+	case receptor.DesiredLRPCreatedEvent:
+		desiredLRP := event.DesiredLRPResponse
+		return fmt.Sprintf("%s %s instances=%d domain=%s", eventType, desiredLRP.ProcessGuid, desiredLRP.Instances, desiredLRP.Domain)
+	case receptor.DesiredLRPChangedEvent:
+		details := []string{}
+		if event.Before.Instances != event.After.Instances {
+			details = append(details, fmt.Sprintf("instances %d -> %d", event.Before.Instances, event.After.Instances))
+		}
+		if !routesEqual(event.Before.Routes, event.After.Routes) {
+			details = append(details, "routes changed")
+		}
+		if event.Before.Annotation != event.After.Annotation {
+			details = append(details, "annotation changed")
+		}
+		return strings.TrimSpace(fmt.Sprintf("%s %s %s", eventType, event.After.ProcessGuid, strings.Join(details, ", ")))
+	case receptor.DesiredLRPRemovedEvent:
+		return fmt.Sprintf("%s %s", eventType, event.DesiredLRPResponse.ProcessGuid)
+	case receptor.ActualLRPCreatedEvent:
+		actualLRP := event.ActualLRPResponse
+		return fmt.Sprintf("%s %s %s", eventType, instanceName(actualLRP), colorState(actualLRP)) + actualLRPDetails(actualLRP)
+	case receptor.ActualLRPChangedEvent:
+		state := colorState(event.After)
+		if event.Before.State != event.After.State {
+			state = colorState(event.Before) + " -> " + state
+		}
+		return fmt.Sprintf("%s %s %s", eventType, instanceName(event.After), state) + actualLRPDetails(event.After)
+	case receptor.ActualLRPRemovedEvent:
+		actualLRP := event.ActualLRPResponse
+		return fmt.Sprintf("%s %s %s", eventType, instanceName(actualLRP), colorState(actualLRP)) + actualLRPDetails(actualLRP)
+	}
+
+	return fmt.Sprintf("%s %s", eventType, event.Key())
+}
+
+func instanceName(actualLRP receptor.ActualLRPResponse) string {
+	return fmt.Sprintf("%s[%d]", actualLRP.ProcessGuid, actualLRP.Index)
+}
+
+func colorState(actualLRP receptor.ActualLRPResponse) string {
+	return presentation.ColorInstanceState(app_examiner.InstanceInfo{
+		State:          string(actualLRP.State),
+		PlacementError: actualLRP.PlacementError,
+	})
+}
+
+func actualLRPDetails(actualLRP receptor.ActualLRPResponse) string {
+	details := ""
+	if actualLRP.CellID != "" {
+		details += " cell=" + actualLRP.CellID
+	}
+	if actualLRP.CrashCount > 0 {
+		details += fmt.Sprintf(" crashes=%d", actualLRP.CrashCount)
+	}
+	if actualLRP.Evacuating {
+		details += " " + colors.Yellow("evacuating")
+	}
+	if actualLRP.CrashReason != "" {
+		details += " crash_reason=" + fmt.Sprintf("%q", actualLRP.CrashReason)
+	}
+	if actualLRP.PlacementError != "" {
+		details += " placement_error=" + fmt.Sprintf("%q", actualLRP.PlacementError)
+	}
+	return details
+}
+
+func routesEqual(before, after receptor.RoutingInfo) bool {
+	beforeJSON, _ := json.Marshal(before)
+	afterJSON, _ := json.Marshal(after)
+	return string(beforeJSON) == string(afterJSON)
+}
+
+func eventTypeNames() []string {
+	names := make([]string, 0, len(event_streamer.EventTypes))
+	for _, eventType := range event_streamer.EventTypes {
+		names = append(names, string(eventType))
+	}
+	return names
+}
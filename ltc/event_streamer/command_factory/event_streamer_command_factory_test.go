@@ -0,0 +1,133 @@
+package command_factory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer/fake_event_streamer"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		eventStreamer *fake_event_streamer.FakeEventStreamer
+		outputBuffer  *gbytes.Buffer
+		terminalUI    terminal.UI
+		clock         *fakeclock.FakeClock
+		exitHandler   *fake_exit_handler.FakeExitHandler
+		eventsCommand cli.Command
+		events        []receptor.Event
+	)
+
+	BeforeEach(func() {
+		eventStreamer = &fake_event_streamer.FakeEventStreamer{}
+		outputBuffer = gbytes.NewBuffer()
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
+		clock = fakeclock.NewFakeClock(time.Date(2015, 4, 1, 13, 14, 15, 0, time.UTC))
+		exitHandler = &fake_exit_handler.FakeExitHandler{}
+
+		events = []receptor.Event{
+			receptor.NewDesiredLRPCreatedEvent(receptor.DesiredLRPResponse{ProcessGuid: "app-a", Instances: 2, Domain: "lattice"}),
+			receptor.NewDesiredLRPChangedEvent(
+				receptor.DesiredLRPResponse{ProcessGuid: "app-a", Instances: 2, Domain: "lattice"},
+				receptor.DesiredLRPResponse{ProcessGuid: "app-a", Instances: 5, Domain: "lattice"},
+			),
+			receptor.NewActualLRPChangedEvent(
+				receptor.ActualLRPResponse{ProcessGuid: "app-b", Index: 1, Domain: "other", State: receptor.ActualLRPStateClaimed},
+				receptor.ActualLRPResponse{ProcessGuid: "app-b", Index: 1, Domain: "other", State: receptor.ActualLRPStateCrashed, CellID: "cell-3", CrashCount: 4, CrashReason: "out of memory"},
+			),
+			receptor.NewActualLRPRemovedEvent(receptor.ActualLRPResponse{ProcessGuid: "app-a", Index: 0, Domain: "lattice", State: receptor.ActualLRPStateRunning, CellID: "cell-1"}),
+		}
+
+		eventStreamer.StreamEventsStub = func(eventCallback func(receptor.Event), errorCallback func(error)) {
+			for _, event := range events {
+				eventCallback(event)
+			}
+			errorCallback(errors.New("source closed"))
+		}
+
+		commandFactory := command_factory.NewEventStreamerCommandFactory(eventStreamer, terminalUI, clock, exitHandler)
+		eventsCommand = commandFactory.MakeEventsCommand()
+	})
+
+	Describe("EventsCommand", func() {
+		It("prints human-readable lines for each event", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{})
+
+			Expect(eventStreamer.StreamEventsCallCount()).To(Equal(1))
+
+			Expect(outputBuffer).To(test_helpers.Say(colors.Cyan("13:14:15") + " " + colors.Bold("desired_lrp_created") + " app-a instances=2 domain=lattice\n"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("desired_lrp_changed") + " app-a instances 2 -> 5\n"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("actual_lrp_changed") + " app-b[1] " + colors.Yellow("CLAIMED") + " -> " + colors.Red("CRASHED") + ` cell=cell-3 crashes=4 crash_reason="out of memory"` + "\n"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Bold("actual_lrp_removed") + " app-a[0] " + colors.Green("RUNNING") + " cell=cell-1\n"))
+			Expect(outputBuffer).To(test_helpers.Say(colors.Red("Event stream error: source closed. Reconnecting...")))
+		})
+
+		It("filters events by app", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{"--app=app-b"})
+
+			Expect(outputBuffer).To(test_helpers.Say("actual_lrp_changed"))
+			Expect(outputBuffer).ToNot(test_helpers.Say("app-a"))
+		})
+
+		It("filters events by type", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{"--type=desired_lrp_changed", "--type=actual_lrp_removed"})
+
+			Expect(outputBuffer).ToNot(test_helpers.Say("desired_lrp_created"))
+			Expect(outputBuffer).To(test_helpers.Say("desired_lrp_changed"))
+			Expect(outputBuffer).ToNot(test_helpers.Say("actual_lrp_changed"))
+			Expect(outputBuffer).To(test_helpers.Say("actual_lrp_removed"))
+		})
+
+		It("filters events by domain", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{"--domain=other"})
+
+			Expect(outputBuffer).ToNot(test_helpers.Say("desired_lrp"))
+			Expect(outputBuffer).To(test_helpers.Say("actual_lrp_changed"))
+			Expect(outputBuffer).ToNot(test_helpers.Say("actual_lrp_removed"))
+		})
+
+		It("outputs one JSON object per line when --json is passed, reporting stream errors on stderr", func() {
+			errorBuffer := gbytes.NewBuffer()
+			terminalUI = terminal.NewUI(nil, outputBuffer, errorBuffer, nil)
+			eventsCommand = command_factory.NewEventStreamerCommandFactory(eventStreamer, terminalUI, clock, exitHandler).MakeEventsCommand()
+
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{"--json", "--type=actual_lrp_removed"})
+
+			Expect(errorBuffer).To(test_helpers.Say("Event stream error: source closed. Reconnecting..."))
+
+			var decodedEvent map[string]interface{}
+			Expect(json.Unmarshal(outputBuffer.Contents(), &decodedEvent)).To(Succeed())
+			Expect(decodedEvent["type"]).To(Equal("actual_lrp_removed"))
+			Expect(decodedEvent["timestamp"]).To(Equal("2015-04-01T13:14:15Z"))
+			Expect(decodedEvent["event"]).To(HaveKeyWithValue("actual_lrp", HaveKeyWithValue("process_guid", "app-a")))
+		})
+
+		It("stops streaming when ltc exits", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{})
+
+			exitHandler.Exit(130)
+
+			Expect(eventStreamer.StopStreamingCallCount()).To(Equal(1))
+		})
+
+		It("rejects unknown event types", func() {
+			test_helpers.ExecuteCommandWithArgs(eventsCommand, []string{"--type=task_created"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: Unknown event type: task_created"))
+			Expect(eventStreamer.StreamEventsCallCount()).To(BeZero())
+		})
+	})
+})
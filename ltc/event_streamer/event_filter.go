@@ -0,0 +1,89 @@
+package event_streamer
+
+import "github.com/cloudfoundry-incubator/receptor"
+
+var EventTypes = []receptor.EventType{
+	receptor.EventTypeDesiredLRPCreated,
+	receptor.EventTypeDesiredLRPChanged,
+	receptor.EventTypeDesiredLRPRemoved,
+	receptor.EventTypeActualLRPCreated,
+	receptor.EventTypeActualLRPChanged,
+	receptor.EventTypeActualLRPRemoved,
+}
+
+type EventFilter struct {
+	AppNames   []string
+	EventTypes []receptor.EventType
+	Domains    []string
+}
+
+func (f EventFilter) Matches(event receptor.Event) bool {
+	if len(f.EventTypes) > 0 && !containsEventType(f.EventTypes, event.EventType()) {
+		return false
+	}
+	if len(f.AppNames) > 0 && !containsString(f.AppNames, ProcessGuid(event)) {
+		return false
+	}
+	if len(f.Domains) > 0 && !containsString(f.Domains, Domain(event)) {
+		return false
+	}
+	return true
+}
+
+func IsValidEventType(eventType string) bool {
+	return containsEventType(EventTypes, receptor.EventType(eventType))
+}
+
+func ProcessGuid(event receptor.Event) string {
+	switch event := event.(type) {
+	case receptor.DesiredLRPCreatedEvent:
+		return event.DesiredLRPResponse.ProcessGuid
+	case receptor.DesiredLRPChangedEvent:
+		return event.After.ProcessGuid
+	case receptor.DesiredLRPRemovedEvent:
+		return event.DesiredLRPResponse.ProcessGuid
+	case receptor.ActualLRPCreatedEvent:
+		return event.ActualLRPResponse.ProcessGuid
+	case receptor.ActualLRPChangedEvent:
+		return event.After.ProcessGuid
+	case receptor.ActualLRPRemovedEvent:
+		return event.ActualLRPResponse.ProcessGuid
+	}
+	return ""
+}
+
+func Domain(event receptor.Event) string {
+	switch event := event.(type) {
+	case receptor.DesiredLRPCreatedEvent:
+		return event.DesiredLRPResponse.Domain
+	case receptor.DesiredLRPChangedEvent:
+		return event.After.Domain
+	case receptor.DesiredLRPRemovedEvent:
+		return event.DesiredLRPResponse.Domain
+	case receptor.ActualLRPCreatedEvent:
+		return event.ActualLRPResponse.Domain
+	case receptor.ActualLRPChangedEvent:
+		return event.After.Domain
+	case receptor.ActualLRPRemovedEvent:
+		return event.ActualLRPResponse.Domain
+	}
+	return ""
+}
+
+func containsEventType(eventTypes []receptor.EventType, eventType receptor.EventType) bool {
+	for _, candidate := range eventTypes {
+		if candidate == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
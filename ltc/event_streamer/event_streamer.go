@@ -0,0 +1,100 @@
+package event_streamer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/pivotal-golang/clock"
+)
+
+const ReconnectInterval = time.Second
+
+//go:generate counterfeiter -o fake_event_streamer/fake_event_streamer.go . EventStreamer
+type EventStreamer interface {
+	StreamEvents(eventCallback func(receptor.Event), errorCallback func(error))
+	StopStreaming()
+}
+
+type eventStreamer struct {
+	receptorClient receptor.Client
+	clock          clock.Clock
+	stopChan       chan struct{}
+
+	lock        sync.Mutex
+	stopped     bool
+	eventSource receptor.EventSource
+}
+
+func New(receptorClient receptor.Client, clock clock.Clock) EventStreamer {
+	return &eventStreamer{
+		receptorClient: receptorClient,
+		clock:          clock,
+		stopChan:       make(chan struct{}),
+	}
+}
+
+func (s *eventStreamer) StreamEvents(eventCallback func(receptor.Event), errorCallback func(error)) {
+	for {
+		eventSource, err := s.receptorClient.SubscribeToEvents()
+		if err != nil {
+			errorCallback(err)
+		} else if !s.setEventSource(eventSource) {
+			eventSource.Close()
+			return
+		} else {
+			s.readEvents(eventSource, eventCallback, errorCallback)
+		}
+
+		select {
+		case <-s.stopChan:
+			return
+		case <-s.clock.NewTimer(ReconnectInterval).C():
+		}
+	}
+}
+
+func (s *eventStreamer) StopStreaming() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stopped {
+		return
+	}
+
+	s.stopped = true
+	close(s.stopChan)
+	if s.eventSource != nil {
+		s.eventSource.Close()
+	}
+}
+
+func (s *eventStreamer) setEventSource(eventSource receptor.EventSource) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stopped {
+		return false
+	}
+
+	s.eventSource = eventSource
+	return true
+}
+
+func (s *eventStreamer) readEvents(eventSource receptor.EventSource, eventCallback func(receptor.Event), errorCallback func(error)) {
+	defer eventSource.Close()
+
+	for {
+		event, err := eventSource.Next()
+		if err != nil {
+			select {
+			case <-s.stopChan:
+			default:
+				errorCallback(err)
+			}
+			return
+		}
+
+		eventCallback(event)
+	}
+}
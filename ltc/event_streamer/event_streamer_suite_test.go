@@ -0,0 +1,13 @@
+package event_streamer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestEventStreamer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "EventStreamer Suite")
+}
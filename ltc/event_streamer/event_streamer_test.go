@@ -0,0 +1,206 @@
+package event_streamer_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry-incubator/receptor/fake_receptor"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+type eventReceiver struct {
+	sync.RWMutex
+	events []receptor.Event
+	errors []error
+}
+
+func (r *eventReceiver) AppendEvent(event receptor.Event) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *eventReceiver) AppendError(err error) {
+	r.Lock()
+	defer r.Unlock()
+	r.errors = append(r.errors, err)
+}
+
+func (r *eventReceiver) GetEvents() []receptor.Event {
+	r.RLock()
+	defer r.RUnlock()
+	return r.events
+}
+
+func (r *eventReceiver) GetErrors() []error {
+	r.RLock()
+	defer r.RUnlock()
+	return r.errors
+}
+
+type blockingEventSource struct {
+	events    chan receptor.Event
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+func newBlockingEventSource() *blockingEventSource {
+	return &blockingEventSource{
+		events:    make(chan receptor.Event),
+		closeChan: make(chan struct{}),
+	}
+}
+
+func (s *blockingEventSource) Next() (receptor.Event, error) {
+	select {
+	case event := <-s.events:
+		return event, nil
+	case <-s.closeChan:
+		return nil, receptor.ErrSourceClosed
+	}
+}
+
+func (s *blockingEventSource) Close() error {
+	s.closeOnce.Do(func() { close(s.closeChan) })
+	return nil
+}
+
+var _ = Describe("EventStreamer", func() {
+	var (
+		fakeReceptorClient *fake_receptor.FakeClient
+		clock              *fakeclock.FakeClock
+		eventStreamer      event_streamer.EventStreamer
+		receiver           *eventReceiver
+	)
+
+	BeforeEach(func() {
+		fakeReceptorClient = &fake_receptor.FakeClient{}
+		clock = fakeclock.NewFakeClock(time.Now())
+		eventStreamer = event_streamer.New(fakeReceptorClient, clock)
+		receiver = &eventReceiver{}
+	})
+
+	Describe("StreamEvents", func() {
+		It("passes events from the receptor event source to the event callback until StopStreaming is called", func() {
+			eventSource := newBlockingEventSource()
+			fakeReceptorClient.SubscribeToEventsReturns(eventSource, nil)
+
+			doneChan := make(chan struct{})
+			go func() {
+				eventStreamer.StreamEvents(receiver.AppendEvent, receiver.AppendError)
+				close(doneChan)
+			}()
+
+			event := receptor.NewDesiredLRPRemovedEvent(receptor.DesiredLRPResponse{ProcessGuid: "app-a"})
+			eventSource.events <- event
+
+			Eventually(receiver.GetEvents).Should(Equal([]receptor.Event{event}))
+
+			eventStreamer.StopStreaming()
+
+			Eventually(doneChan).Should(BeClosed())
+			Expect(receiver.GetErrors()).To(BeEmpty())
+			Expect(fakeReceptorClient.SubscribeToEventsCallCount()).To(Equal(1))
+		})
+
+		It("reports errors and resubscribes when the event source closes", func() {
+			firstEventSource := &fake_receptor.FakeEventSource{}
+			firstEventSource.NextReturns(nil, receptor.ErrSourceClosed)
+			secondEventSource := newBlockingEventSource()
+
+			fakeReceptorClient.SubscribeToEventsStub = func() (receptor.EventSource, error) {
+				if fakeReceptorClient.SubscribeToEventsCallCount() == 1 {
+					return firstEventSource, nil
+				}
+				return secondEventSource, nil
+			}
+
+			doneChan := make(chan struct{})
+			go func() {
+				eventStreamer.StreamEvents(receiver.AppendEvent, receiver.AppendError)
+				close(doneChan)
+			}()
+
+			Eventually(receiver.GetErrors).Should(Equal([]error{receptor.ErrSourceClosed}))
+			Expect(firstEventSource.CloseCallCount()).To(Equal(1))
+			Expect(fakeReceptorClient.SubscribeToEventsCallCount()).To(Equal(1))
+
+			Eventually(clock.WatcherCount).Should(Equal(1))
+			clock.Increment(event_streamer.ReconnectInterval)
+
+			Eventually(fakeReceptorClient.SubscribeToEventsCallCount).Should(Equal(2))
+
+			event := receptor.NewActualLRPRemovedEvent(receptor.ActualLRPResponse{ProcessGuid: "app-a"})
+			secondEventSource.events <- event
+			Eventually(receiver.GetEvents).Should(Equal([]receptor.Event{event}))
+
+			eventStreamer.StopStreaming()
+			Eventually(doneChan).Should(BeClosed())
+		})
+
+		It("reports subscription errors and retries", func() {
+			fakeReceptorClient.SubscribeToEventsReturns(nil, errors.New("receptor is down"))
+
+			doneChan := make(chan struct{})
+			go func() {
+				eventStreamer.StreamEvents(receiver.AppendEvent, receiver.AppendError)
+				close(doneChan)
+			}()
+
+			Eventually(receiver.GetErrors).Should(Equal([]error{errors.New("receptor is down")}))
+
+			Eventually(clock.WatcherCount).Should(Equal(1))
+			clock.Increment(event_streamer.ReconnectInterval)
+
+			Eventually(fakeReceptorClient.SubscribeToEventsCallCount).Should(Equal(2))
+
+			eventStreamer.StopStreaming()
+			Eventually(doneChan).Should(BeClosed())
+		})
+	})
+
+	Describe("EventFilter", func() {
+		var (
+			desiredEvent receptor.Event
+			actualEvent  receptor.Event
+		)
+
+		BeforeEach(func() {
+			desiredEvent = receptor.NewDesiredLRPCreatedEvent(receptor.DesiredLRPResponse{ProcessGuid: "app-a", Domain: "lattice"})
+			actualEvent = receptor.NewActualLRPChangedEvent(
+				receptor.ActualLRPResponse{ProcessGuid: "app-b", Domain: "other"},
+				receptor.ActualLRPResponse{ProcessGuid: "app-b", Domain: "other"},
+			)
+		})
+
+		It("matches everything when empty", func() {
+			filter := event_streamer.EventFilter{}
+			Expect(filter.Matches(desiredEvent)).To(BeTrue())
+			Expect(filter.Matches(actualEvent)).To(BeTrue())
+		})
+
+		It("filters by app name", func() {
+			filter := event_streamer.EventFilter{AppNames: []string{"app-b"}}
+			Expect(filter.Matches(desiredEvent)).To(BeFalse())
+			Expect(filter.Matches(actualEvent)).To(BeTrue())
+		})
+
+		It("filters by event type", func() {
+			filter := event_streamer.EventFilter{EventTypes: []receptor.EventType{receptor.EventTypeDesiredLRPCreated}}
+			Expect(filter.Matches(desiredEvent)).To(BeTrue())
+			Expect(filter.Matches(actualEvent)).To(BeFalse())
+		})
+
+		It("filters by domain", func() {
+			filter := event_streamer.EventFilter{Domains: []string{"other"}}
+			Expect(filter.Matches(desiredEvent)).To(BeFalse())
+			Expect(filter.Matches(actualEvent)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// This file was generated by counterfeiter
+package fake_event_streamer
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer"
+	"github.com/cloudfoundry-incubator/receptor"
+)
+
+type FakeEventStreamer struct {
+	StreamEventsStub        func(eventCallback func(receptor.Event), errorCallback func(error))
+	streamEventsMutex       sync.RWMutex
+	streamEventsArgsForCall []struct {
+		eventCallback func(receptor.Event)
+		errorCallback func(error)
+	}
+	StopStreamingStub        func()
+	stopStreamingMutex       sync.RWMutex
+	stopStreamingArgsForCall []struct{}
+}
+
+func (fake *FakeEventStreamer) StreamEvents(eventCallback func(receptor.Event), errorCallback func(error)) {
+	fake.streamEventsMutex.Lock()
+	fake.streamEventsArgsForCall = append(fake.streamEventsArgsForCall, struct {
+		eventCallback func(receptor.Event)
+		errorCallback func(error)
+	}{eventCallback, errorCallback})
+	fake.streamEventsMutex.Unlock()
+	if fake.StreamEventsStub != nil {
+		fake.StreamEventsStub(eventCallback, errorCallback)
+	}
+}
+
+func (fake *FakeEventStreamer) StreamEventsCallCount() int {
+	fake.streamEventsMutex.RLock()
+	defer fake.streamEventsMutex.RUnlock()
+	return len(fake.streamEventsArgsForCall)
+}
+
+func (fake *FakeEventStreamer) StreamEventsArgsForCall(i int) (func(receptor.Event), func(error)) {
+	fake.streamEventsMutex.RLock()
+	defer fake.streamEventsMutex.RUnlock()
+	return fake.streamEventsArgsForCall[i].eventCallback, fake.streamEventsArgsForCall[i].errorCallback
+}
+
+func (fake *FakeEventStreamer) StopStreaming() {
+	fake.stopStreamingMutex.Lock()
+	fake.stopStreamingArgsForCall = append(fake.stopStreamingArgsForCall, struct{}{})
+	fake.stopStreamingMutex.Unlock()
+	if fake.StopStreamingStub != nil {
+		fake.StopStreamingStub()
+	}
+}
+
+func (fake *FakeEventStreamer) StopStreamingCallCount() int {
+	fake.stopStreamingMutex.RLock()
+	defer fake.stopStreamingMutex.RUnlock()
+	return len(fake.stopStreamingArgsForCall)
+}
+
+var _ event_streamer.EventStreamer = new(FakeEventStreamer)
@@ -0,0 +1,30 @@
+// Package exit_code_helpers maps the errors the command factories get back
+// from the app runner, app examiner and receptor client to exit codes, so
+// that exit_codes itself depends on nothing.
+package exit_code_helpers
+
+import (
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/receptor"
+)
+
+// ForError returns the exit code for an error returned by the app runner,
+// app examiner or receptor client, falling back to GeneralError.
+func ForError(err error) int {
+	switch typedErr := err.(type) {
+	case app_examiner.AppNotFoundError, docker_app_runner.AppNotStartedError:
+		return exit_codes.AppNotFound
+	case docker_app_runner.ExistingAppError:
+		return exit_codes.AppAlreadyExists
+	case docker_app_runner.RegistryError:
+		return exit_codes.RegistryError
+	case receptor.Error:
+		if typedErr.Type == receptor.Unauthorized {
+			return exit_codes.Unauthorized
+		}
+	}
+
+	return exit_codes.GeneralError
+}
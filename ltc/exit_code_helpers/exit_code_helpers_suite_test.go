@@ -0,0 +1,13 @@
+package exit_code_helpers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestExitCodeHelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ExitCodeHelpers Suite")
+}
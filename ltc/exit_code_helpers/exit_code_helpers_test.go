@@ -0,0 +1,39 @@
+package exit_code_helpers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_code_helpers"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/receptor"
+)
+
+var _ = Describe("ForError", func() {
+	It("maps missing apps to AppNotFound", func() {
+		Expect(exit_code_helpers.ForError(app_examiner.AppNotFoundError("cool-web-app"))).To(Equal(exit_codes.AppNotFound))
+		Expect(exit_code_helpers.ForError(docker_app_runner.AppNotStartedError("cool-web-app"))).To(Equal(exit_codes.AppNotFound))
+	})
+
+	It("maps existing apps to AppAlreadyExists", func() {
+		Expect(exit_code_helpers.ForError(docker_app_runner.ExistingAppError("cool-web-app"))).To(Equal(exit_codes.AppAlreadyExists))
+	})
+
+	It("maps registry failures to RegistryError", func() {
+		err := docker_app_runner.NewRegistryError("cool-web/app", errors.New("Unknown tag"))
+		Expect(exit_code_helpers.ForError(err)).To(Equal(exit_codes.RegistryError))
+	})
+
+	It("maps unauthorized receptor errors to Unauthorized", func() {
+		Expect(exit_code_helpers.ForError(receptor.Error{Type: receptor.Unauthorized})).To(Equal(exit_codes.Unauthorized))
+	})
+
+	It("maps anything else to GeneralError", func() {
+		Expect(exit_code_helpers.ForError(receptor.Error{Type: receptor.DesiredLRPNotFound})).To(Equal(exit_codes.GeneralError))
+		Expect(exit_code_helpers.ForError(errors.New("boom"))).To(Equal(exit_codes.GeneralError))
+	})
+})
@@ -0,0 +1,245 @@
+package cancellable_receptor_client
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cloudfoundry-incubator/receptor"
+)
+
+var ErrCancelled = errors.New("Cancelled.")
+
+type cancellableClient struct {
+	client    receptor.Client
+	cancelled <-chan struct{}
+}
+
+// New wraps a receptor client so that every call returns ErrCancelled as soon
+// as cancelled is closed, instead of waiting on a hung receptor.
+func New(client receptor.Client, cancelled <-chan struct{}) receptor.Client {
+	return &cancellableClient{client, cancelled}
+}
+
+func (c *cancellableClient) do(call func() error) error {
+	select {
+	case <-c.cancelled:
+		return ErrCancelled
+	default:
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- call()
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-c.cancelled:
+		return ErrCancelled
+	}
+}
+
+func (c *cancellableClient) CreateTask(request receptor.TaskCreateRequest) error {
+	return c.do(func() error {
+		return c.client.CreateTask(request)
+	})
+}
+
+func (c *cancellableClient) Tasks() ([]receptor.TaskResponse, error) {
+	var response []receptor.TaskResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.Tasks()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) TasksByDomain(domain string) ([]receptor.TaskResponse, error) {
+	var response []receptor.TaskResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.TasksByDomain(domain)
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) GetTask(taskId string) (receptor.TaskResponse, error) {
+	var response receptor.TaskResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.GetTask(taskId)
+		return err
+	})
+	if err == ErrCancelled {
+		return receptor.TaskResponse{}, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) DeleteTask(taskId string) error {
+	return c.do(func() error {
+		return c.client.DeleteTask(taskId)
+	})
+}
+
+func (c *cancellableClient) CancelTask(taskId string) error {
+	return c.do(func() error {
+		return c.client.CancelTask(taskId)
+	})
+}
+
+func (c *cancellableClient) CreateDesiredLRP(request receptor.DesiredLRPCreateRequest) error {
+	return c.do(func() error {
+		return c.client.CreateDesiredLRP(request)
+	})
+}
+
+func (c *cancellableClient) GetDesiredLRP(processGuid string) (receptor.DesiredLRPResponse, error) {
+	var response receptor.DesiredLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.GetDesiredLRP(processGuid)
+		return err
+	})
+	if err == ErrCancelled {
+		return receptor.DesiredLRPResponse{}, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) UpdateDesiredLRP(processGuid string, update receptor.DesiredLRPUpdateRequest) error {
+	return c.do(func() error {
+		return c.client.UpdateDesiredLRP(processGuid, update)
+	})
+}
+
+func (c *cancellableClient) DeleteDesiredLRP(processGuid string) error {
+	return c.do(func() error {
+		return c.client.DeleteDesiredLRP(processGuid)
+	})
+}
+
+func (c *cancellableClient) DesiredLRPs() ([]receptor.DesiredLRPResponse, error) {
+	var response []receptor.DesiredLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.DesiredLRPs()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) DesiredLRPsByDomain(domain string) ([]receptor.DesiredLRPResponse, error) {
+	var response []receptor.DesiredLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.DesiredLRPsByDomain(domain)
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) ActualLRPs() ([]receptor.ActualLRPResponse, error) {
+	var response []receptor.ActualLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.ActualLRPs()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) ActualLRPsByDomain(domain string) ([]receptor.ActualLRPResponse, error) {
+	var response []receptor.ActualLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.ActualLRPsByDomain(domain)
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) ActualLRPsByProcessGuid(processGuid string) ([]receptor.ActualLRPResponse, error) {
+	var response []receptor.ActualLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.ActualLRPsByProcessGuid(processGuid)
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) ActualLRPByProcessGuidAndIndex(processGuid string, index int) (receptor.ActualLRPResponse, error) {
+	var response receptor.ActualLRPResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.ActualLRPByProcessGuidAndIndex(processGuid, index)
+		return err
+	})
+	if err == ErrCancelled {
+		return receptor.ActualLRPResponse{}, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) KillActualLRPByProcessGuidAndIndex(processGuid string, index int) error {
+	return c.do(func() error {
+		return c.client.KillActualLRPByProcessGuidAndIndex(processGuid, index)
+	})
+}
+
+func (c *cancellableClient) SubscribeToEvents() (receptor.EventSource, error) {
+	var response receptor.EventSource
+	err := c.do(func() (err error) {
+		response, err = c.client.SubscribeToEvents()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) Cells() ([]receptor.CellResponse, error) {
+	var response []receptor.CellResponse
+	err := c.do(func() (err error) {
+		response, err = c.client.Cells()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
+
+func (c *cancellableClient) UpsertDomain(domain string, ttl time.Duration) error {
+	return c.do(func() error {
+		return c.client.UpsertDomain(domain, ttl)
+	})
+}
+
+func (c *cancellableClient) Domains() ([]string, error) {
+	var response []string
+	err := c.do(func() (err error) {
+		response, err = c.client.Domains()
+		return err
+	})
+	if err == ErrCancelled {
+		return nil, err
+	}
+	return response, err
+}
@@ -0,0 +1,75 @@
+package cancellable_receptor_client_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/cancellable_receptor_client"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry-incubator/receptor/fake_receptor"
+)
+
+var _ = Describe("CancellableReceptorClient", func() {
+	var (
+		fakeReceptorClient *fake_receptor.FakeClient
+		cancelled          chan struct{}
+		client             receptor.Client
+	)
+
+	BeforeEach(func() {
+		fakeReceptorClient = &fake_receptor.FakeClient{}
+		cancelled = make(chan struct{})
+		client = cancellable_receptor_client.New(fakeReceptorClient, cancelled)
+	})
+
+	It("passes calls through to the wrapped client", func() {
+		fakeReceptorClient.GetDesiredLRPReturns(receptor.DesiredLRPResponse{ProcessGuid: "cool-web-app"}, nil)
+
+		desiredLRP, err := client.GetDesiredLRP("cool-web-app")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(desiredLRP.ProcessGuid).To(Equal("cool-web-app"))
+		Expect(fakeReceptorClient.GetDesiredLRPArgsForCall(0)).To(Equal("cool-web-app"))
+	})
+
+	It("passes errors through from the wrapped client", func() {
+		fakeReceptorClient.DeleteDesiredLRPReturns(errors.New("deleting failed"))
+
+		err := client.DeleteDesiredLRP("cool-web-app")
+
+		Expect(err).To(MatchError("deleting failed"))
+	})
+
+	It("returns ErrCancelled without calling the receptor once cancelled", func() {
+		close(cancelled)
+
+		_, err := client.DesiredLRPs()
+
+		Expect(err).To(Equal(cancellable_receptor_client.ErrCancelled))
+		Expect(fakeReceptorClient.DesiredLRPsCallCount()).To(BeZero())
+	})
+
+	It("returns ErrCancelled for a call in flight when cancelled", func() {
+		blockChan := make(chan struct{})
+		defer close(blockChan)
+		fakeReceptorClient.ActualLRPsStub = func() ([]receptor.ActualLRPResponse, error) {
+			<-blockChan
+			return []receptor.ActualLRPResponse{{ProcessGuid: "cool-web-app"}}, nil
+		}
+
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := client.ActualLRPs()
+			errChan <- err
+		}()
+
+		Eventually(fakeReceptorClient.ActualLRPsCallCount).Should(Equal(1))
+		Consistently(errChan).ShouldNot(Receive())
+
+		close(cancelled)
+
+		Eventually(errChan).Should(Receive(Equal(cancellable_receptor_client.ErrCancelled)))
+	})
+})
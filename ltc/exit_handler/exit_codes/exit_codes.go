@@ -1,7 +1,21 @@
 package exit_codes
 
+// Exit codes returned by ltc. These are part of the CLI's interface and are
+// listed in the README; do not renumber them.
 const (
-	BadTarget      = 12
-	PlacementError = 22
-	SigInt         = 130
+	GeneralError     = 1
+	InvalidSyntax    = 2
+	BadTarget        = 12
+	Unauthorized     = 13
+	PlacementError   = 22
+	CrashLoop        = 23
+	Timeout          = 24
+	AppNotFound      = 25
+	AppAlreadyExists = 26
+	RegistryError    = 27
+	LogStreamLost    = 28
+	SigHup           = 129
+	SigInt           = 130
+	SigQuit          = 131
+	SigTerm          = 143
 )
@@ -1,18 +1,38 @@
 package exit_handler
 
 import (
+	"fmt"
+	"io"
 	"os"
+	"syscall"
+	"time"
 
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 )
 
-func New(signalChan chan os.Signal, systemExit func(code int)) ExitHandler {
+const ForceExitHintDelay = time.Second
+
+// ExitSignals are the signals that start a graceful exit. Sending any of them
+// again while the exit funcs are still running forces an immediate exit.
+var ExitSignals = []os.Signal{os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT}
+
+var signalExitCodes = map[os.Signal]int{
+	os.Interrupt:    exit_codes.SigInt,
+	syscall.SIGTERM: exit_codes.SigTerm,
+	syscall.SIGHUP:  exit_codes.SigHup,
+	syscall.SIGQUIT: exit_codes.SigQuit,
+}
+
+func New(signalChan chan os.Signal, systemExit func(code int), errorOutput io.Writer) ExitHandler {
 	return &exitHandler{
 		signalChan:      signalChan,
 		systemExit:      systemExit,
+		errorOutput:     errorOutput,
 		onExitFuncs:     make([]func(), 0),
 		onExitFuncsChan: make(chan func()),
-		exitCode:        exit_codes.SigInt,
+		exitChan:        make(chan int),
+		cancelled:       make(chan struct{}),
+		exited:          make(chan struct{}),
 	}
 }
 
@@ -20,38 +40,98 @@ type ExitHandler interface {
 	Run()
 	OnExit(exitFunc func())
 	Exit(code int)
+	Cancelled() <-chan struct{}
 }
 
 type exitHandler struct {
 	onExitFuncs     []func()
 	onExitFuncsChan chan func()
 	signalChan      chan os.Signal
+	exitChan        chan int
 	systemExit      func(int)
-	exitCode        int
+	errorOutput     io.Writer
+	cancelled       chan struct{}
+	exited          chan struct{}
 }
 
 func (e *exitHandler) Run() {
+	var exitCode int
+	var exitFuncsDone chan struct{}
+	var forceExitHint <-chan time.Time
+
 	for {
 		select {
 		case signal := <-e.signalChan:
-			if signal == os.Interrupt {
-				for _, exitFunc := range e.onExitFuncs {
-					exitFunc()
-				}
-				e.systemExit(e.exitCode)
+			code, ok := signalExitCodes[signal]
+			if !ok {
+				continue
+			}
+			if exitFuncsDone != nil {
+				e.exit(code)
 				return
 			}
+			exitCode = code
+			exitFuncsDone = e.startExiting()
+			forceExitHint = time.After(ForceExitHintDelay)
+		case code := <-e.exitChan:
+			if exitFuncsDone == nil {
+				exitCode = code
+				exitFuncsDone = e.startExiting()
+			}
+		case <-forceExitHint:
+			fmt.Fprintln(e.errorOutput, "\nExiting... press Ctrl-C again to force.")
+		case <-exitFuncsDone:
+			e.exit(exitCode)
+			return
 		case exitFunc := <-e.onExitFuncsChan:
-			e.onExitFuncs = append(e.onExitFuncs, exitFunc)
+			if exitFuncsDone == nil {
+				e.onExitFuncs = append(e.onExitFuncs, exitFunc)
+			}
 		}
 	}
 }
 
+// startExiting cancels in-flight work and runs the exit funcs in the
+// background, so that a second signal can still be received.
+func (e *exitHandler) startExiting() chan struct{} {
+	close(e.cancelled)
+
+	exitFuncsDone := make(chan struct{})
+	go func(exitFuncs []func()) {
+		for _, exitFunc := range exitFuncs {
+			exitFunc()
+		}
+		close(exitFuncsDone)
+	}(e.onExitFuncs)
+
+	return exitFuncsDone
+}
+
+func (e *exitHandler) exit(code int) {
+	e.systemExit(code)
+	close(e.exited)
+}
+
 func (e *exitHandler) OnExit(exitFunc func()) {
-	e.onExitFuncsChan <- exitFunc
+	select {
+	case e.onExitFuncsChan <- exitFunc:
+	case <-e.exited:
+	}
 }
 
+// Exit blocks until the system exit has been called, so that callers on the
+// main goroutine cannot return and exit 0 before the exit code is delivered.
+// If an exit is already under way, its exit code wins.
 func (e *exitHandler) Exit(code int) {
-	e.exitCode = code
-	e.signalChan <- os.Interrupt
+	select {
+	case e.exitChan <- code:
+	case <-e.exited:
+	}
+	<-e.exited
+}
+
+// Cancelled is closed as soon as an exit begins. Long-running work should
+// stop when it is closed.
+func (e *exitHandler) Cancelled() <-chan struct{} {
+	return e.cancelled
 }
@@ -10,24 +10,32 @@ import (
 	"github.com/onsi/gomega/gbytes"
 
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 )
 
 var _ = Describe("ExitHandler", func() {
-	var buffer *gbytes.Buffer
+	var (
+		buffer      *gbytes.Buffer
+		errorBuffer *gbytes.Buffer
+		signalChan  chan os.Signal
+		exitHandler exit_handler.ExitHandler
+	)
 
 	BeforeEach(func() {
 		buffer = gbytes.NewBuffer()
-	})
+		errorBuffer = gbytes.NewBuffer()
+		signalChan = make(chan os.Signal)
 
-	It("Executes exit handlers on os.Interupts", func() {
+		exitBuffer := buffer
 		exitFunc := func(code int) {
-			buffer.Write([]byte(fmt.Sprintf("Exit-Code=%d", code)))
+			exitBuffer.Write([]byte(fmt.Sprintf("Exit-Code=%d", code)))
 		}
 
-		signalChan := make(chan os.Signal)
-		exitHandler := exit_handler.New(signalChan, exitFunc)
+		exitHandler = exit_handler.New(signalChan, exitFunc, errorBuffer)
 		go exitHandler.Run()
+	})
 
+	It("Executes exit handlers on os.Interupts", func() {
 		exitHandler.OnExit(func() {
 			buffer.Write([]byte("handler1"))
 		})
@@ -36,7 +44,7 @@ var _ = Describe("ExitHandler", func() {
 			buffer.Write([]byte("handler2"))
 		})
 
-		signalChan <- syscall.SIGHUP
+		signalChan <- syscall.SIGUSR1
 
 		Consistently(buffer).ShouldNot(gbytes.Say("handler"))
 
@@ -47,16 +55,70 @@ var _ = Describe("ExitHandler", func() {
 		Eventually(buffer).Should(gbytes.Say("Exit-Code=130"))
 	})
 
-	Describe("Exit", func() {
-		It("triggers a system exit after calling all the exit funcs ", func() {
-			exitFunc := func(code int) {
-				buffer.Write([]byte(fmt.Sprintf("Exit-Code=%d", code)))
+	It("executes exit handlers on SIGTERM, SIGHUP and SIGQUIT", func() {
+		exitHandler.OnExit(func() {
+			buffer.Write([]byte("handler1"))
+		})
+
+		signalChan <- syscall.SIGTERM
+
+		Eventually(buffer).Should(gbytes.Say("handler1"))
+		Eventually(buffer).Should(gbytes.Say("Exit-Code=%d", exit_codes.SigTerm))
+	})
+
+	It("closes the cancellation channel before running the exit handlers", func() {
+		cancelledBeforeHandler := make(chan bool, 1)
+		exitHandler.OnExit(func() {
+			select {
+			case <-exitHandler.Cancelled():
+				cancelledBeforeHandler <- true
+			default:
+				cancelledBeforeHandler <- false
 			}
+		})
+
+		Expect(exitHandler.Cancelled()).ToNot(BeClosed())
+
+		signalChan <- syscall.SIGHUP
+
+		Eventually(cancelledBeforeHandler).Should(Receive(BeTrue()))
+		Eventually(buffer).Should(gbytes.Say("Exit-Code=%d", exit_codes.SigHup))
+	})
 
-			signalChan := make(chan os.Signal)
-			exitHandler := exit_handler.New(signalChan, exitFunc)
-			go exitHandler.Run()
+	Context("when the exit handlers do not finish", func() {
+		var blockChan chan struct{}
 
+		BeforeEach(func() {
+			blockChan = make(chan struct{})
+			unblocked := blockChan
+			exitHandler.OnExit(func() {
+				<-unblocked
+			})
+		})
+
+		AfterEach(func() {
+			close(blockChan)
+		})
+
+		It("tells the user how to force the exit", func() {
+			signalChan <- os.Interrupt
+
+			Eventually(errorBuffer, 2*exit_handler.ForceExitHintDelay).Should(gbytes.Say("press Ctrl-C again to force"))
+			Consistently(buffer).ShouldNot(gbytes.Say("Exit-Code"))
+		})
+
+		It("exits immediately on a second signal", func() {
+			signalChan <- os.Interrupt
+			Consistently(buffer).ShouldNot(gbytes.Say("Exit-Code"))
+
+			signalChan <- syscall.SIGQUIT
+
+			Eventually(buffer).Should(gbytes.Say("Exit-Code=%d", exit_codes.SigQuit))
+		})
+	})
+
+	Describe("Exit", func() {
+		It("triggers a system exit after calling all the exit funcs ", func() {
 			exitHandler.OnExit(func() {
 				buffer.Write([]byte("handler1"))
 			})
@@ -70,6 +132,35 @@ var _ = Describe("ExitHandler", func() {
 			Eventually(buffer).Should(gbytes.Say("handler1"))
 			Eventually(buffer).Should(gbytes.Say("handler2"))
 			Eventually(buffer).Should(gbytes.Say("Exit-Code=222"))
+			Expect(exitHandler.Cancelled()).To(BeClosed())
+		})
+
+		It("does not return until the system exit has been called", func() {
+			exitHandler.Exit(24)
+
+			Expect(buffer).To(gbytes.Say("Exit-Code=24"))
+		})
+
+		It("keeps the exit code of an exit that is already under way", func() {
+			blockChan := make(chan struct{})
+			exitHandler.OnExit(func() {
+				<-blockChan
+			})
+
+			signalChan <- os.Interrupt
+			Eventually(exitHandler.Cancelled()).Should(BeClosed())
+
+			exited := make(chan struct{})
+			go func() {
+				exitHandler.Exit(24)
+				close(exited)
+			}()
+
+			Consistently(exited).ShouldNot(BeClosed())
+			close(blockChan)
+
+			Eventually(exited).Should(BeClosed())
+			Expect(buffer).To(gbytes.Say("Exit-Code=130"))
 		})
 	})
 })
@@ -5,6 +5,7 @@ import "sync"
 type FakeExitHandler struct {
 	sync.RWMutex
 	exitFunc       func()
+	cancelled      chan struct{}
 	ExitCalledWith []int
 }
 
@@ -26,3 +27,25 @@ func (f *FakeExitHandler) Exit(code int) {
 		f.exitFunc()
 	}
 }
+
+func (f *FakeExitHandler) Cancelled() <-chan struct{} {
+	f.Lock()
+	defer f.Unlock()
+	if f.cancelled == nil {
+		f.cancelled = make(chan struct{})
+	}
+	return f.cancelled
+}
+
+// Cancel closes the channel returned by Cancelled, as the real exit handler
+// does when an exit begins.
+func (f *FakeExitHandler) Cancel() {
+	f.Cancelled()
+	f.Lock()
+	defer f.Unlock()
+	select {
+	case <-f.cancelled:
+	default:
+		close(f.cancelled)
+	}
+}
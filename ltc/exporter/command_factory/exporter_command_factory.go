@@ -0,0 +1,127 @@
+package command_factory
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exporter"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock"
+)
+
+const (
+	DefaultExporterAddress = "localhost:9099"
+	DefaultRefreshInterval = 15 * time.Second
+)
+
+type ExporterCommandFactory struct {
+	exporter      exporter.Exporter
+	eventStreamer event_streamer.EventStreamer
+	ui            terminal.UI
+	clock         clock.Clock
+	exitHandler   exit_handler.ExitHandler
+}
+
+func NewExporterCommandFactory(exporter exporter.Exporter, eventStreamer event_streamer.EventStreamer, ui terminal.UI, clock clock.Clock, exitHandler exit_handler.ExitHandler) *ExporterCommandFactory {
+	return &ExporterCommandFactory{exporter, eventStreamer, ui, clock, exitHandler}
+}
+
+func (factory *ExporterCommandFactory) MakeExporterCommand() cli.Command {
+	var exporterFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "address, a",
+			Usage: "Address to serve /metrics on",
+			Value: DefaultExporterAddress,
+		},
+		cli.DurationFlag{
+			Name:  "interval, i",
+			Usage: "How often to refresh cluster state (e.g., \"30s\")",
+			Value: DefaultRefreshInterval,
+		},
+		cli.BoolFlag{
+			Name:  "watch-events, w",
+			Usage: "Also refresh whenever the receptor reports an app or instance change",
+		},
+	}
+
+	return cli.Command{
+		Name:        "exporter",
+		ShortName:   "ex",
+		Usage:       "Serves Prometheus metrics for the apps and cells of the targeted lattice",
+		Description: "ltc exporter [--address=HOST:PORT] [--interval=DURATION] [--watch-events]",
+		Action:      factory.serveMetrics,
+		Flags:       exporterFlags,
+	}
+}
+
+func (factory *ExporterCommandFactory) serveMetrics(context *cli.Context) {
+	interval := context.Duration("interval")
+	if interval <= 0 {
+		factory.ui.IncorrectUsage("Interval must be a positive duration")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	listener, err := net.Listen("tcp", context.String("address"))
+	if err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error starting exporter: %s", err))
+		factory.exitHandler.Exit(exit_codes.GeneralError)
+		return
+	}
+
+	if err := factory.exporter.Refresh(); err != nil {
+		factory.ui.SayError(fmt.Sprintf("Error fetching cluster state: %s", err))
+	}
+
+	stopChan := make(chan struct{})
+	refreshChan := make(chan struct{}, 1)
+
+	factory.exitHandler.OnExit(func() {
+		close(stopChan)
+		factory.eventStreamer.StopStreaming()
+		listener.Close()
+	})
+
+	if context.Bool("watch-events") {
+		go factory.eventStreamer.StreamEvents(func(receptor.Event) {
+			select {
+			case refreshChan <- struct{}{}:
+			default:
+			}
+		}, func(err error) {
+			factory.ui.SayError(fmt.Sprintf("Event stream error: %s. Reconnecting...", err))
+		})
+	}
+
+	go factory.refreshLoop(interval, refreshChan, stopChan)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", factory.exporter)
+
+	factory.ui.SayLine(fmt.Sprintf("Serving metrics on http://%s/metrics", listener.Addr()))
+
+	http.Serve(listener, mux)
+}
+
+func (factory *ExporterCommandFactory) refreshLoop(interval time.Duration, refreshChan <-chan struct{}, stopChan <-chan struct{}) {
+	timer := factory.clock.NewTimer(interval)
+	for {
+		select {
+		case <-stopChan:
+			timer.Stop()
+			return
+		case <-refreshChan:
+		case <-timer.C():
+		}
+
+		factory.exporter.Refresh()
+		timer.Reset(interval)
+	}
+}
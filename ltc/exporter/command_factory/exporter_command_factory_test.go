@@ -0,0 +1,151 @@
+package command_factory_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/event_streamer/fake_event_streamer"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exporter"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exporter/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock/fakeclock"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		appExaminer     *fake_app_examiner.FakeAppExaminer
+		eventStreamer   *fake_event_streamer.FakeEventStreamer
+		outputBuffer    *gbytes.Buffer
+		clock           *fakeclock.FakeClock
+		exitHandler     *fake_exit_handler.FakeExitHandler
+		exporterCommand cli.Command
+	)
+
+	BeforeEach(func() {
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		eventStreamer = &fake_event_streamer.FakeEventStreamer{}
+		outputBuffer = gbytes.NewBuffer()
+		clock = fakeclock.NewFakeClock(time.Now())
+		exitHandler = &fake_exit_handler.FakeExitHandler{}
+
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app", DesiredInstances: 2}}, nil)
+
+		metricExporter := exporter.New(appExaminer, nil, clock, lagertest.NewTestLogger("exporter"))
+		commandFactory := command_factory.NewExporterCommandFactory(metricExporter, eventStreamer, terminal.NewUI(nil, outputBuffer, outputBuffer, nil), clock, exitHandler)
+		exporterCommand = commandFactory.MakeExporterCommand()
+	})
+
+	Describe("ExporterCommand", func() {
+		startExporter := func(args ...string) (string, chan struct{}) {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(exporterCommand, append([]string{"--address=127.0.0.1:0"}, args...))
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Serving metrics on "))
+			var metricsURL string
+			Eventually(func() string {
+				metricsURL = regexp.MustCompile(`http://\S+/metrics`).FindString(string(outputBuffer.Contents()))
+				return metricsURL
+			}).ShouldNot(BeEmpty())
+
+			return metricsURL, commandFinishChan
+		}
+
+		scrape := func(metricsURL string) string {
+			response, err := http.Get(metricsURL)
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		It("serves metrics collected at startup and stops on exit", func() {
+			metricsURL, commandFinishChan := startExporter()
+
+			Expect(scrape(metricsURL)).To(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 2`))
+			Expect(eventStreamer.StreamEventsCallCount()).To(BeZero())
+
+			exitHandler.Exit(130)
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(eventStreamer.StopStreamingCallCount()).To(Equal(1))
+		})
+
+		It("refreshes on the configured interval", func() {
+			metricsURL, commandFinishChan := startExporter("--interval=30s")
+			Expect(appExaminer.ListAppsCallCount()).To(Equal(1))
+
+			appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app", DesiredInstances: 5}}, nil)
+			clock.Increment(30 * time.Second)
+
+			Eventually(func() string { return scrape(metricsURL) }).Should(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 5`))
+
+			exitHandler.Exit(130)
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("refreshes when the receptor reports changes with --watch-events", func() {
+			eventCallbacks := make(chan func(receptor.Event), 1)
+			eventStreamer.StreamEventsStub = func(eventCallback func(receptor.Event), errorCallback func(error)) {
+				eventCallbacks <- eventCallback
+			}
+
+			metricsURL, commandFinishChan := startExporter("--watch-events")
+
+			var eventCallback func(receptor.Event)
+			Eventually(eventCallbacks).Should(Receive(&eventCallback))
+
+			appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app", DesiredInstances: 7}}, nil)
+			eventCallback(receptor.NewDesiredLRPRemovedEvent(receptor.DesiredLRPResponse{ProcessGuid: "cool-web-app"}))
+
+			Eventually(func() string { return scrape(metricsURL) }).Should(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 7`))
+
+			exitHandler.Exit(130)
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("reports errors watching the receptor's events", func() {
+			errorCallbacks := make(chan func(error), 1)
+			eventStreamer.StreamEventsStub = func(eventCallback func(receptor.Event), errorCallback func(error)) {
+				errorCallbacks <- errorCallback
+			}
+
+			_, commandFinishChan := startExporter("--watch-events")
+
+			var errorCallback func(error)
+			Eventually(errorCallbacks).Should(Receive(&errorCallback))
+			errorCallback(errors.New("connection reset"))
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Event stream error: connection reset. Reconnecting..."))
+
+			exitHandler.Exit(130)
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("rejects a non-positive interval", func() {
+			test_helpers.ExecuteCommandWithArgs(exporterCommand, []string{"--interval=0s"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+		})
+
+		It("reports errors listening on the address", func() {
+			test_helpers.ExecuteCommandWithArgs(exporterCommand, []string{"--address=not-an-address"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Error starting exporter: "))
+		})
+	})
+})
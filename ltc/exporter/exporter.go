@@ -0,0 +1,204 @@
+package exporter
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock"
+	"github.com/pivotal-golang/lager"
+)
+
+const ContentType = "text/plain; version=0.0.4"
+
+var instanceStates = []string{
+	string(receptor.ActualLRPStateUnclaimed),
+	string(receptor.ActualLRPStateClaimed),
+	string(receptor.ActualLRPStateRunning),
+	string(receptor.ActualLRPStateCrashed),
+}
+
+type ContainerMetricsFetcher interface {
+	ContainerMetrics(appGuid string, authToken string) ([]*events.ContainerMetric, error)
+}
+
+// Exporter serves the most recently collected cluster state as Prometheus
+// metrics. Refresh collects a new snapshot; scrapes never hit the receptor.
+type Exporter interface {
+	http.Handler
+	Refresh() error
+}
+
+type exporter struct {
+	appExaminer             app_examiner.AppExaminer
+	containerMetricsFetcher ContainerMetricsFetcher
+	clock                   clock.Clock
+	logger                  lager.Logger
+
+	lock          sync.RWMutex
+	snapshot      *metricWriter
+	lastRefresh   float64
+	refreshErrors int
+	up            bool
+}
+
+// New returns an Exporter. containerMetricsFetcher may be nil, in which case
+// per-instance container metrics are not reported.
+func New(appExaminer app_examiner.AppExaminer, containerMetricsFetcher ContainerMetricsFetcher, clock clock.Clock, logger lager.Logger) Exporter {
+	return &exporter{
+		appExaminer:             appExaminer,
+		containerMetricsFetcher: containerMetricsFetcher,
+		clock:                   clock,
+		logger:                  logger.Session("exporter"),
+		snapshot:                newMetricWriter(),
+	}
+}
+
+func (e *exporter) Refresh() error {
+	snapshot, err := e.collect()
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err != nil {
+		e.logger.Error("failed-to-refresh", err)
+		e.refreshErrors++
+		e.up = false
+		return err
+	}
+
+	e.snapshot = snapshot
+	e.lastRefresh = float64(e.clock.Now().UnixNano()) / 1e9
+	e.up = true
+	return nil
+}
+
+func (e *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	writer := newMetricWriter()
+	for name, m := range e.snapshot.metrics {
+		writer.metrics[name] = m
+	}
+	writer.gauge("lattice_up", "Whether the last refresh of cluster state succeeded.", boolToFloat(e.up))
+	writer.gauge("lattice_exporter_last_refresh_timestamp_seconds", "Unix time of the last successful refresh.", e.lastRefresh)
+	writer.counter("lattice_exporter_refresh_errors_total", "Number of failed refreshes of cluster state.", float64(e.refreshErrors))
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Write(writer.bytes())
+}
+
+func (e *exporter) collect() (*metricWriter, error) {
+	apps, err := e.appExaminer.ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	cells, err := e.appExaminer.ListCells()
+	if err != nil {
+		return nil, err
+	}
+
+	writer := newMetricWriter()
+	for _, app := range apps {
+		e.collectApp(writer, app)
+	}
+
+	missingCells := 0
+	for _, cell := range cells {
+		collectCell(writer, cell)
+		if cell.Missing {
+			missingCells++
+		}
+	}
+	writer.gauge("lattice_cells", "Number of cells known to the cluster.", float64(len(cells)))
+	writer.gauge("lattice_cells_missing", "Number of cells running instances but no longer reporting presence.", float64(missingCells))
+
+	return writer, nil
+}
+
+func (e *exporter) collectApp(writer *metricWriter, app app_examiner.AppInfo) {
+	appLabel := label{"app", app.ProcessGuid}
+
+	writer.gauge("lattice_app_desired_instances", "Number of instances desired for the app.", float64(app.DesiredInstances), appLabel)
+	writer.gauge("lattice_app_running_instances", "Number of running instances of the app.", float64(app.ActualRunningInstances), appLabel)
+
+	instancesByState := make(map[string]int)
+	for _, instance := range app.ActualInstances {
+		instancesByState[instance.State]++
+	}
+	for _, state := range instanceStates {
+		writer.gauge("lattice_app_instances", "Number of instances of the app by state.", float64(instancesByState[state]), appLabel, label{"state", state})
+	}
+
+	crashCounts := crashCountsByIndex(app.ActualInstances)
+	indexes := make([]int, 0, len(crashCounts))
+	for index := range crashCounts {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	crashes := 0
+	for _, index := range indexes {
+		crashes += crashCounts[index]
+		writer.gauge("lattice_app_instance_crash_count", "Number of times the instance has crashed.", float64(crashCounts[index]), appLabel, label{"index", strconv.Itoa(index)})
+	}
+	writer.gauge("lattice_app_crash_count", "Total number of crashes across the app's instances.", float64(crashes), appLabel)
+
+	if e.containerMetricsFetcher == nil {
+		return
+	}
+
+	containerMetrics, err := e.containerMetricsFetcher.ContainerMetrics(app.LogGuid, "")
+	if err != nil {
+		e.logger.Debug("failed-to-fetch-container-metrics", lager.Data{"app": app.ProcessGuid, "error": err.Error()})
+		return
+	}
+
+	for _, containerMetric := range containerMetrics {
+		indexLabel := label{"index", strconv.Itoa(int(containerMetric.GetInstanceIndex()))}
+		writer.gauge("lattice_app_instance_cpu_percentage", "CPU usage of the instance's container.", containerMetric.GetCpuPercentage(), appLabel, indexLabel)
+		writer.gauge("lattice_app_instance_memory_bytes", "Memory usage of the instance's container.", float64(containerMetric.GetMemoryBytes()), appLabel, indexLabel)
+		writer.gauge("lattice_app_instance_disk_bytes", "Disk usage of the instance's container.", float64(containerMetric.GetDiskBytes()), appLabel, indexLabel)
+	}
+}
+
+// crashCountsByIndex keeps one crash count per index. While an instance is
+// evacuated, the evacuating and the replacement instance share its index and
+// report the same crash count, so the highest one is kept.
+func crashCountsByIndex(instances []app_examiner.InstanceInfo) map[int]int {
+	crashCounts := make(map[int]int)
+	for _, instance := range instances {
+		if instance.CrashCount >= crashCounts[instance.Index] {
+			crashCounts[instance.Index] = instance.CrashCount
+		}
+	}
+	return crashCounts
+}
+
+func collectCell(writer *metricWriter, cell app_examiner.CellInfo) {
+	cellLabels := []label{{"cell", cell.CellID}, {"zone", cell.Zone}}
+
+	writer.gauge("lattice_cell_missing", "Whether the cell is running instances but no longer reporting presence.", boolToFloat(cell.Missing), cellLabels...)
+	writer.gauge("lattice_cell_capacity_memory_mb", "Memory capacity of the cell in MB.", float64(cell.Capacity.MemoryMB), cellLabels...)
+	writer.gauge("lattice_cell_capacity_disk_mb", "Disk capacity of the cell in MB.", float64(cell.Capacity.DiskMB), cellLabels...)
+	writer.gauge("lattice_cell_capacity_containers", "Container capacity of the cell.", float64(cell.Capacity.Containers), cellLabels...)
+	writer.gauge("lattice_cell_reserved_memory_mb", "Memory reserved by instances on the cell in MB.", float64(cell.Reserved.MemoryMB), cellLabels...)
+	writer.gauge("lattice_cell_reserved_disk_mb", "Disk reserved by instances on the cell in MB.", float64(cell.Reserved.DiskMB), cellLabels...)
+	writer.gauge("lattice_cell_reserved_containers", "Containers reserved by instances on the cell.", float64(cell.Reserved.Containers), cellLabels...)
+	writer.gauge("lattice_cell_running_instances", "Number of running instances on the cell.", float64(cell.RunningInstances), cellLabels...)
+	writer.gauge("lattice_cell_claimed_instances", "Number of claimed instances on the cell.", float64(cell.ClaimedInstances), cellLabels...)
+	writer.gauge("lattice_cell_evacuating_instances", "Number of evacuating instances on the cell.", float64(cell.EvacuatingInstances), cellLabels...)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
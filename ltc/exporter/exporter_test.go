@@ -0,0 +1,188 @@
+package exporter_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exporter"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock/fakeclock"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+type fakeContainerMetricsFetcher struct {
+	metrics map[string][]*events.ContainerMetric
+	err     error
+}
+
+func (f *fakeContainerMetricsFetcher) ContainerMetrics(appGuid string, authToken string) ([]*events.ContainerMetric, error) {
+	return f.metrics[appGuid], f.err
+}
+
+var _ = Describe("Exporter", func() {
+	var (
+		appExaminer    *fake_app_examiner.FakeAppExaminer
+		metricsFetcher *fakeContainerMetricsFetcher
+		clock          *fakeclock.FakeClock
+		metricExporter exporter.Exporter
+	)
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		metricExporter.ServeHTTP(recorder, &http.Request{})
+		Expect(recorder.Header().Get("Content-Type")).To(Equal(exporter.ContentType))
+
+		body, err := ioutil.ReadAll(recorder.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	BeforeEach(func() {
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		metricsFetcher = &fakeContainerMetricsFetcher{metrics: map[string][]*events.ContainerMetric{}}
+		clock = fakeclock.NewFakeClock(time.Unix(1427894055, 0))
+
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{
+			{
+				ProcessGuid:            "cool-web-app",
+				LogGuid:                "cool-web-app-logs",
+				DesiredInstances:       3,
+				ActualRunningInstances: 2,
+				ActualInstances: []app_examiner.InstanceInfo{
+					{Index: 0, State: "RUNNING"},
+					{Index: 1, State: "RUNNING", CrashCount: 1},
+					{Index: 2, State: "CRASHED", CrashCount: 4},
+				},
+			},
+		}, nil)
+		appExaminer.ListCellsReturns([]app_examiner.CellInfo{
+			{
+				CellID:           "cell-1",
+				Zone:             "z1",
+				Capacity:         app_examiner.CellResources{MemoryMB: 4096, DiskMB: 8192, Containers: 256},
+				Reserved:         app_examiner.CellResources{MemoryMB: 256, DiskMB: 2048, Containers: 2},
+				RunningInstances: 2,
+			},
+			{CellID: "cell-2", Missing: true, RunningInstances: 1},
+		}, nil)
+
+		cpu, memory, disk, index := 12.5, uint64(1048576), uint64(2097152), int32(1)
+		metricsFetcher.metrics["cool-web-app-logs"] = []*events.ContainerMetric{
+			{InstanceIndex: &index, CpuPercentage: &cpu, MemoryBytes: &memory, DiskBytes: &disk},
+		}
+	})
+
+	JustBeforeEach(func() {
+		metricExporter = exporter.New(appExaminer, metricsFetcher, clock, lagertest.NewTestLogger("exporter"))
+	})
+
+	It("reports app metrics", func() {
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring("# TYPE lattice_app_desired_instances gauge\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 3` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_running_instances{app="cool-web-app"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instances{app="cool-web-app",state="RUNNING"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instances{app="cool-web-app",state="CRASHED"} 1` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instances{app="cool-web-app",state="UNCLAIMED"} 0` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_crash_count{app="cool-web-app"} 5` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instance_crash_count{app="cool-web-app",index="2"} 4` + "\n"))
+	})
+
+	It("reports one crash count per index while an instance is evacuated", func() {
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{
+			{
+				ProcessGuid: "cool-web-app",
+				ActualInstances: []app_examiner.InstanceInfo{
+					{Index: 0, State: "RUNNING", CrashCount: 2, CellID: "cell-1"},
+					{Index: 0, State: "CLAIMED", CrashCount: 2, CellID: "cell-2"},
+				},
+			},
+		}, nil)
+
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(strings.Count(metrics, `lattice_app_instance_crash_count{app="cool-web-app",index="0"}`)).To(Equal(1))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instance_crash_count{app="cool-web-app",index="0"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_crash_count{app="cool-web-app"} 2` + "\n"))
+	})
+
+	It("reports container metrics when available", func() {
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`lattice_app_instance_cpu_percentage{app="cool-web-app",index="1"} 12.5` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instance_memory_bytes{app="cool-web-app",index="1"} 1.048576e+06` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_instance_disk_bytes{app="cool-web-app",index="1"} 2.097152e+06` + "\n"))
+	})
+
+	It("skips container metrics when they cannot be fetched", func() {
+		metricsFetcher.err = errors.New("doppler unavailable")
+
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(metrics).ToNot(ContainSubstring("lattice_app_instance_cpu_percentage"))
+		Expect(metrics).To(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 3`))
+	})
+
+	It("reports cell metrics", func() {
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`lattice_cell_capacity_memory_mb{cell="cell-1",zone="z1"} 4096` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_cell_reserved_containers{cell="cell-1",zone="z1"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_cell_running_instances{cell="cell-1",zone="z1"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring(`lattice_cell_missing{cell="cell-2",zone=""} 1` + "\n"))
+		Expect(metrics).To(ContainSubstring("lattice_cells 2\n"))
+		Expect(metrics).To(ContainSubstring("lattice_cells_missing 1\n"))
+	})
+
+	It("reports the health of the exporter", func() {
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring("lattice_up 1\n"))
+		Expect(metrics).To(ContainSubstring("lattice_exporter_last_refresh_timestamp_seconds 1.427894055e+09\n"))
+		Expect(metrics).To(ContainSubstring("# TYPE lattice_exporter_refresh_errors_total counter\nlattice_exporter_refresh_errors_total 0\n"))
+	})
+
+	Context("when refreshing fails", func() {
+		It("keeps serving the last snapshot and reports the failure", func() {
+			Expect(metricExporter.Refresh()).To(Succeed())
+
+			appExaminer.ListCellsReturns(nil, errors.New("receptor down"))
+			Expect(metricExporter.Refresh()).To(MatchError("receptor down"))
+
+			metrics := scrape()
+			Expect(metrics).To(ContainSubstring(`lattice_app_desired_instances{app="cool-web-app"} 3`))
+			Expect(metrics).To(ContainSubstring("lattice_up 0\n"))
+			Expect(metrics).To(ContainSubstring("lattice_exporter_refresh_errors_total 1\n"))
+		})
+	})
+
+	It("escapes label values", func() {
+		appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: `we"ird\app`}}, nil)
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		Expect(scrape()).To(ContainSubstring(`lattice_app_desired_instances{app="we\"ird\\app"} 0`))
+	})
+
+	It("does not report container metrics without a fetcher", func() {
+		metricExporter = exporter.New(appExaminer, nil, clock, lagertest.NewTestLogger("exporter"))
+		Expect(metricExporter.Refresh()).To(Succeed())
+
+		Expect(scrape()).ToNot(ContainSubstring("lattice_app_instance_cpu_percentage"))
+	})
+})
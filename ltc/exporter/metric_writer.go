@@ -0,0 +1,89 @@
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	labels []label
+	value  float64
+}
+
+type metric struct {
+	name       string
+	help       string
+	metricType string
+	samples    []sample
+}
+
+// metricWriter renders metrics in the Prometheus text exposition format.
+type metricWriter struct {
+	metrics map[string]*metric
+}
+
+func newMetricWriter() *metricWriter {
+	return &metricWriter{metrics: make(map[string]*metric)}
+}
+
+func (w *metricWriter) gauge(name, help string, value float64, labels ...label) {
+	w.add(name, help, "gauge", value, labels)
+}
+
+func (w *metricWriter) counter(name, help string, value float64, labels ...label) {
+	w.add(name, help, "counter", value, labels)
+}
+
+func (w *metricWriter) add(name, help, metricType string, value float64, labels []label) {
+	m, ok := w.metrics[name]
+	if !ok {
+		m = &metric{name: name, help: help, metricType: metricType}
+		w.metrics[name] = m
+	}
+	m.samples = append(m.samples, sample{labels: labels, value: value})
+}
+
+func (w *metricWriter) bytes() []byte {
+	names := make([]string, 0, len(w.metrics))
+	for name := range w.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buffer := &bytes.Buffer{}
+	for _, name := range names {
+		m := w.metrics[name]
+		fmt.Fprintf(buffer, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(buffer, "# TYPE %s %s\n", m.name, m.metricType)
+		for _, s := range m.samples {
+			fmt.Fprintf(buffer, "%s%s %s\n", m.name, formatLabels(s.labels), strconv.FormatFloat(s.value, 'g', -1, 64))
+		}
+	}
+	return buffer.Bytes()
+}
+
+func formatLabels(labels []label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	formatted := make([]string, 0, len(labels))
+	for _, l := range labels {
+		formatted = append(formatted, fmt.Sprintf(`%s="%s"`, l.name, escapeLabelValue(l.value)))
+	}
+	return "{" + strings.Join(formatted, ",") + "}"
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
@@ -0,0 +1,189 @@
+package command_factory
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/clock"
+)
+
+// DroppedReportInterval is how often envelopes dropped because the output
+// could not keep up are reported.
+var DroppedReportInterval = time.Second
+
+type FirehoseCommandFactory struct {
+	firehoseReader firehose.FirehoseReader
+	ui             terminal.UI
+	clock          clock.Clock
+	exitHandler    exit_handler.ExitHandler
+	isTerminal     bool
+}
+
+func NewFirehoseCommandFactory(firehoseReader firehose.FirehoseReader, ui terminal.UI, clock clock.Clock, exitHandler exit_handler.ExitHandler, isTerminal bool) *FirehoseCommandFactory {
+	return &FirehoseCommandFactory{firehoseReader, ui, clock, exitHandler, isTerminal}
+}
+
+func (factory *FirehoseCommandFactory) MakeFirehoseCommand() cli.Command {
+	var firehoseFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "subscription-id",
+			Usage: "Firehose subscription ID; clients sharing an ID split the stream between them (defaults to a new ID)",
+		},
+		cli.StringSliceFlag{
+			Name:  "type, t",
+			Usage: "Only show envelopes of this type: LogMessage, ContainerMetric, ValueMetric, CounterEvent or HttpStartStop (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "origin",
+			Usage: "Only show envelopes from this origin, e.g. rep or router (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "app",
+			Usage: "Only show envelopes for this app (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringFlag{
+			Name:  "format, f",
+			Usage: "Output format: text or json",
+			Value: logs.FormatText,
+		},
+		cli.StringFlag{
+			Name:  "timestamp-format",
+			Usage: "Timestamp layout for text output: short, rfc3339 (with nanoseconds) or a Go time layout",
+			Value: logs.TimestampFormatShort,
+		},
+		cli.BoolFlag{
+			Name:  "utc",
+			Usage: "Show timestamps in UTC instead of local time",
+		},
+	}
+	firehoseFlags = append(firehoseFlags, logs.OutputFlags("envelopes")...)
+
+	return cli.Command{
+		Name:      "firehose",
+		ShortName: "fh",
+		Usage:     "Streams every log and metric envelope in the lattice cluster",
+		Description: `ltc firehose [--subscription-id=ID] [--type=TYPE] [--origin=ORIGIN] [--app=APP_NAME] [--format=text|json] [--output=FILE]
+
+   e.g. to follow the container metrics of one app as JSON:
+   		ltc firehose --type=ContainerMetric --app=my-app --format=json
+
+   Envelopes are dropped when the output cannot keep up. Dropped envelopes,
+   stream errors and a lost connection are reported on stderr.`,
+		Action: factory.tailFirehose,
+		Flags:  firehoseFlags,
+	}
+}
+
+func (factory *FirehoseCommandFactory) tailFirehose(context *cli.Context) {
+	filter, err := envelopeFilterFromFlags(context)
+	if err != nil {
+		factory.ui.IncorrectUsage(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	formatter := firehose.EnvelopeFormatter{
+		Format:          context.String("format"),
+		TimestampLayout: logs.TimestampLayout(context.String("timestamp-format")),
+		UTC:             context.Bool("utc"),
+		Color:           factory.isTerminal && context.String("output") == "",
+	}
+	if !firehose.IsValidFormat(formatter.Format) {
+		factory.ui.IncorrectUsage(fmt.Sprintf("Unknown format: %s. Supported formats are: %s", formatter.Format, strings.Join(firehose.Formats, ", ")))
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	var writer io.Writer = factory.ui
+	outputFile, ok := logs.OpenOutputFile(context, factory.ui, factory.exitHandler)
+	if !ok {
+		return
+	}
+	if outputFile != nil {
+		defer outputFile.Close()
+
+		writer = outputFile
+		factory.ui.SayLine(fmt.Sprintf("Writing the firehose to %s", context.String("output")))
+	}
+
+	subscriptionId := context.String("subscription-id")
+	if subscriptionId == "" {
+		subscriptionId = "ltc-firehose-" + strconv.FormatInt(factory.clock.Now().UnixNano(), 36)
+	}
+
+	buffer := logs.NewLineBuffer(factory.clock)
+	envelopeCallback := func(envelope *events.Envelope) {
+		if filter.Matches(envelope) {
+			buffer.Add(envelope.GetTimestamp(), formatter.FormatEnvelope(envelope))
+		}
+	}
+	errorCallback := func(err error) {
+		factory.ui.SayError(err.Error())
+	}
+
+	tailDone := make(chan struct{})
+	go func() {
+		factory.firehoseReader.TailFirehose(subscriptionId, envelopeCallback, errorCallback)
+		close(tailDone)
+	}()
+
+	ticker := factory.clock.NewTicker(DroppedReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case line := <-buffer.Lines():
+			fmt.Fprintln(writer, line.Line)
+		case <-ticker.C():
+			buffer.ReportDropped(factory.ui, "envelopes")
+		case <-tailDone:
+			factory.flush(writer, buffer)
+			factory.ui.SayError("Lost connection to the firehose.")
+			factory.exitHandler.Exit(exit_codes.LogStreamLost)
+			return
+		case <-factory.exitHandler.Cancelled():
+			factory.firehoseReader.StopTailing()
+			<-tailDone
+			factory.flush(writer, buffer)
+			return
+		}
+	}
+}
+
+// flush writes the envelopes still buffered once the firehose has stopped.
+func (factory *FirehoseCommandFactory) flush(writer io.Writer, buffer *logs.LineBuffer) {
+	for _, line := range buffer.Drain() {
+		fmt.Fprintln(writer, line.Line)
+	}
+	buffer.ReportDropped(factory.ui, "envelopes")
+}
+
+func envelopeFilterFromFlags(context *cli.Context) (firehose.EnvelopeFilter, error) {
+	filter := firehose.EnvelopeFilter{
+		Origins: context.StringSlice("origin"),
+		AppIds:  context.StringSlice("app"),
+	}
+
+	for _, eventTypeName := range context.StringSlice("type") {
+		eventType, err := firehose.ParseEventType(eventTypeName)
+		if err != nil {
+			return firehose.EnvelopeFilter{}, err
+		}
+		filter.EventTypes = append(filter.EventTypes, eventType)
+	}
+
+	return filter, nil
+}
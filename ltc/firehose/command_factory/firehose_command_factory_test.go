@@ -0,0 +1,212 @@
+package command_factory_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose/fake_firehose_reader"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/codegangsta/cli"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+var _ = Describe("CommandFactory", func() {
+	var (
+		firehoseReader  *fake_firehose_reader.FakeFirehoseReader
+		outputBuffer    *gbytes.Buffer
+		errorBuffer     *gbytes.Buffer
+		clock           *fakeclock.FakeClock
+		exitHandler     *fake_exit_handler.FakeExitHandler
+		firehoseCommand cli.Command
+		envelopes       []*events.Envelope
+	)
+
+	BeforeEach(func() {
+		firehoseReader = &fake_firehose_reader.FakeFirehoseReader{}
+		outputBuffer = gbytes.NewBuffer()
+		errorBuffer = gbytes.NewBuffer()
+		clock = fakeclock.NewFakeClock(time.Now())
+		exitHandler = &fake_exit_handler.FakeExitHandler{}
+
+		envelopes = []*events.Envelope{
+			{
+				Origin:     proto.String("rep"),
+				EventType:  events.Envelope_LogMessage.Enum(),
+				LogMessage: &events.LogMessage{AppId: proto.String("my-app"), SourceType: proto.String("APP"), SourceInstance: proto.String("0"), Message: []byte("hello")},
+			},
+			{
+				Origin:      proto.String("router"),
+				EventType:   events.Envelope_ValueMetric.Enum(),
+				ValueMetric: &events.ValueMetric{Name: proto.String("latency"), Value: proto.Float64(1.5), Unit: proto.String("ms")},
+			},
+		}
+
+		stopChan := make(chan struct{})
+		firehoseReader.TailFirehoseStub = func(subscriptionId string, envelopeCallback func(*events.Envelope), errorCallback func(error)) {
+			for _, envelope := range envelopes {
+				envelopeCallback(envelope)
+			}
+			errorCallback(errors.New("reconnecting"))
+			<-stopChan
+		}
+		firehoseReader.StopTailingStub = func() {
+			close(stopChan)
+		}
+
+		commandFactory := command_factory.NewFirehoseCommandFactory(firehoseReader, terminal.NewUI(nil, outputBuffer, errorBuffer, nil), clock, exitHandler, false)
+		firehoseCommand = commandFactory.MakeFirehoseCommand()
+	})
+
+	Describe("FirehoseCommand", func() {
+		It("streams every envelope with a new subscription id until cancelled", func() {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("[rep] LogMessage my-app [APP|0] hello\n"))
+			Eventually(outputBuffer).Should(test_helpers.Say("[router] ValueMetric latency=1.5 ms\n"))
+			Eventually(errorBuffer).Should(test_helpers.Say("reconnecting\n"))
+			Expect(outputBuffer.Contents()).ToNot(ContainSubstring("reconnecting"))
+
+			subscriptionId, _, _ := firehoseReader.TailFirehoseArgsForCall(0)
+			Expect(subscriptionId).To(Equal("ltc-firehose-" + strconv.FormatInt(clock.Now().UnixNano(), 36)))
+
+			Consistently(commandFinishChan).ShouldNot(BeClosed())
+			exitHandler.Cancel()
+
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(firehoseReader.StopTailingCallCount()).To(Equal(1))
+			Expect(exitHandler.ExitCalledWith).To(BeEmpty())
+		})
+
+		It("uses the given subscription id", func() {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{"--subscription-id=shared"})
+
+			Eventually(firehoseReader.TailFirehoseCallCount).Should(Equal(1))
+			subscriptionId, _, _ := firehoseReader.TailFirehoseArgsForCall(0)
+			Expect(subscriptionId).To(Equal("shared"))
+
+			exitHandler.Cancel()
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("filters envelopes by type, origin and app", func() {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{"--type=logmessage", "--origin=rep", "--app=my-app"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("LogMessage my-app"))
+			Eventually(errorBuffer).Should(test_helpers.Say("reconnecting"))
+
+			exitHandler.Cancel()
+			Eventually(commandFinishChan).Should(BeClosed())
+			Expect(outputBuffer.Contents()).ToNot(ContainSubstring("ValueMetric"))
+		})
+
+		It("renders envelopes as json", func() {
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{"--format=json", "--type=ValueMetric"})
+
+			Eventually(outputBuffer).Should(test_helpers.Say(`"event_type":"ValueMetric"`))
+
+			exitHandler.Cancel()
+			Eventually(commandFinishChan).Should(BeClosed())
+		})
+
+		It("writes envelopes to a file", func() {
+			tmpDir, err := ioutil.TempDir("", "firehose-output")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			outputPath := filepath.Join(tmpDir, "firehose.log")
+			commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{"--output=" + outputPath})
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Writing the firehose to " + outputPath))
+			Eventually(func() string {
+				contents, _ := ioutil.ReadFile(outputPath)
+				return string(contents)
+			}).Should(ContainSubstring("ValueMetric latency=1.5 ms"))
+
+			Eventually(errorBuffer).Should(test_helpers.Say("reconnecting"))
+
+			exitHandler.Cancel()
+			Eventually(commandFinishChan).Should(BeClosed())
+
+			contents, err := ioutil.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).ToNot(ContainSubstring("reconnecting"))
+		})
+
+		Context("when the output cannot keep up", func() {
+			var originalOutputBufferSize int
+
+			BeforeEach(func() {
+				originalOutputBufferSize = logs.OutputBufferSize
+				logs.OutputBufferSize = 1
+			})
+
+			AfterEach(func() {
+				logs.OutputBufferSize = originalOutputBufferSize
+			})
+
+			It("drops envelopes instead of blocking the firehose, and reports them", func() {
+				slowReader, slowWriter := io.Pipe()
+				commandFactory := command_factory.NewFirehoseCommandFactory(firehoseReader, terminal.NewUI(nil, slowWriter, errorBuffer, nil), clock, exitHandler, false)
+				firehoseCommand = commandFactory.MakeFirehoseCommand()
+
+				for i := 0; i < 3; i++ {
+					envelopes = append(envelopes, envelopes...)
+				}
+
+				commandFinishChan := test_helpers.AsyncExecuteCommandWithArgs(firehoseCommand, []string{})
+
+				Eventually(errorBuffer).Should(test_helpers.Say("reconnecting"))
+				go io.Copy(outputBuffer, slowReader)
+
+				Eventually(clock.WatcherCount).Should(BeNumerically(">", 0))
+				clock.Increment(command_factory.DroppedReportInterval)
+				Eventually(errorBuffer).Should(gbytes.Say(`Dropped \d+ envelopes because the output could not keep up.`))
+
+				exitHandler.Cancel()
+				Eventually(commandFinishChan).Should(BeClosed())
+			})
+		})
+
+		It("exits when the firehose connection is lost", func() {
+			firehoseReader.TailFirehoseStub = nil
+
+			test_helpers.ExecuteCommandWithArgs(firehoseCommand, []string{})
+
+			Expect(errorBuffer).To(test_helpers.Say("Lost connection to the firehose."))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.LogStreamLost}))
+		})
+
+		It("rejects unknown envelope types", func() {
+			test_helpers.ExecuteCommandWithArgs(firehoseCommand, []string{"--type=Gauge"})
+
+			Expect(errorBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(errorBuffer).To(test_helpers.Say("Unknown envelope type: Gauge"))
+			Expect(firehoseReader.TailFirehoseCallCount()).To(BeZero())
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("rejects unknown formats", func() {
+			test_helpers.ExecuteCommandWithArgs(firehoseCommand, []string{"--format=raw"})
+
+			Expect(errorBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(errorBuffer).To(test_helpers.Say("Unknown format: raw. Supported formats are: text, json"))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package firehose
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/noaa/events"
+)
+
+// EnvelopeFilter selects which firehose envelopes are shown. Empty fields
+// match everything.
+type EnvelopeFilter struct {
+	EventTypes []events.Envelope_EventType
+	Origins    []string
+	AppIds     []string
+}
+
+func (filter EnvelopeFilter) Matches(envelope *events.Envelope) bool {
+	if len(filter.EventTypes) > 0 && !containsEventType(filter.EventTypes, envelope.GetEventType()) {
+		return false
+	}
+
+	if len(filter.Origins) > 0 && !containsFold(filter.Origins, envelope.GetOrigin()) {
+		return false
+	}
+
+	if len(filter.AppIds) > 0 && !containsFold(filter.AppIds, AppId(envelope)) {
+		return false
+	}
+
+	return true
+}
+
+// ParseEventType accepts an envelope event type such as LogMessage in any
+// case.
+func ParseEventType(eventType string) (events.Envelope_EventType, error) {
+	for name, value := range events.Envelope_EventType_value {
+		if strings.EqualFold(name, eventType) {
+			return events.Envelope_EventType(value), nil
+		}
+	}
+	return 0, fmt.Errorf("Unknown envelope type: %s", eventType)
+}
+
+// AppId returns the app an envelope belongs to, or "" for cluster-wide
+// events such as ValueMetric and CounterEvent.
+func AppId(envelope *events.Envelope) string {
+	switch envelope.GetEventType() {
+	case events.Envelope_LogMessage:
+		return envelope.GetLogMessage().GetAppId()
+	case events.Envelope_ContainerMetric:
+		return envelope.GetContainerMetric().GetApplicationId()
+	case events.Envelope_HttpStartStop:
+		return formatUUID(envelope.GetHttpStartStop().GetApplicationId())
+	}
+	return ""
+}
+
+func formatUUID(uuid *events.UUID) string {
+	if uuid == nil {
+		return ""
+	}
+
+	var uuidBytes [16]byte
+	binary.LittleEndian.PutUint64(uuidBytes[:8], uuid.GetLow())
+	binary.LittleEndian.PutUint64(uuidBytes[8:], uuid.GetHigh())
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuidBytes[0:4], uuidBytes[4:6], uuidBytes[6:8], uuidBytes[8:10], uuidBytes[10:])
+}
+
+func containsEventType(eventTypes []events.Envelope_EventType, eventType events.Envelope_EventType) bool {
+	for _, candidate := range eventTypes {
+		if candidate == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package firehose_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/gogo/protobuf/proto"
+)
+
+var _ = Describe("EnvelopeFilter", func() {
+	var logEnvelope, metricEnvelope, valueEnvelope *events.Envelope
+
+	BeforeEach(func() {
+		logEnvelope = &events.Envelope{
+			Origin:     proto.String("rep"),
+			EventType:  events.Envelope_LogMessage.Enum(),
+			LogMessage: &events.LogMessage{AppId: proto.String("my-app")},
+		}
+		metricEnvelope = &events.Envelope{
+			Origin:          proto.String("rep"),
+			EventType:       events.Envelope_ContainerMetric.Enum(),
+			ContainerMetric: &events.ContainerMetric{ApplicationId: proto.String("other-app")},
+		}
+		valueEnvelope = &events.Envelope{
+			Origin:      proto.String("router"),
+			EventType:   events.Envelope_ValueMetric.Enum(),
+			ValueMetric: &events.ValueMetric{Name: proto.String("uptime")},
+		}
+	})
+
+	It("matches everything when empty", func() {
+		filter := firehose.EnvelopeFilter{}
+
+		Expect(filter.Matches(logEnvelope)).To(BeTrue())
+		Expect(filter.Matches(metricEnvelope)).To(BeTrue())
+		Expect(filter.Matches(valueEnvelope)).To(BeTrue())
+	})
+
+	It("filters by event type", func() {
+		filter := firehose.EnvelopeFilter{EventTypes: []events.Envelope_EventType{events.Envelope_ContainerMetric, events.Envelope_ValueMetric}}
+
+		Expect(filter.Matches(logEnvelope)).To(BeFalse())
+		Expect(filter.Matches(metricEnvelope)).To(BeTrue())
+		Expect(filter.Matches(valueEnvelope)).To(BeTrue())
+	})
+
+	It("filters by origin, ignoring case", func() {
+		filter := firehose.EnvelopeFilter{Origins: []string{"Router"}}
+
+		Expect(filter.Matches(logEnvelope)).To(BeFalse())
+		Expect(filter.Matches(valueEnvelope)).To(BeTrue())
+	})
+
+	It("filters by app, dropping cluster-wide events", func() {
+		filter := firehose.EnvelopeFilter{AppIds: []string{"my-app", "other-app"}}
+
+		Expect(filter.Matches(logEnvelope)).To(BeTrue())
+		Expect(filter.Matches(metricEnvelope)).To(BeTrue())
+		Expect(filter.Matches(valueEnvelope)).To(BeFalse())
+	})
+
+	Describe("ParseEventType", func() {
+		It("parses event types in any case", func() {
+			Expect(firehose.ParseEventType("httpstartstop")).To(Equal(events.Envelope_HttpStartStop))
+			Expect(firehose.ParseEventType("CounterEvent")).To(Equal(events.Envelope_CounterEvent))
+		})
+
+		It("rejects unknown event types", func() {
+			_, err := firehose.ParseEventType("Gauge")
+			Expect(err).To(MatchError("Unknown envelope type: Gauge"))
+		})
+	})
+
+	Describe("AppId", func() {
+		It("formats the app id of http events as a uuid", func() {
+			envelope := &events.Envelope{
+				EventType: events.Envelope_HttpStartStop.Enum(),
+				HttpStartStop: &events.HttpStartStop{
+					ApplicationId: &events.UUID{Low: proto.Uint64(0x0706050403020100), High: proto.Uint64(0x0f0e0d0c0b0a0908)},
+				},
+			}
+
+			Expect(firehose.AppId(envelope)).To(Equal("00010203-0405-0607-0809-0a0b0c0d0e0f"))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry/noaa/events"
+)
+
+var Formats = []string{logs.FormatText, logs.FormatJSON}
+
+// EnvelopeFormatter renders firehose envelopes as a line of text or a JSON
+// object, using the formats of ltc logs.
+type EnvelopeFormatter struct {
+	Format          string
+	TimestampLayout string
+	UTC             bool
+	Color           bool
+}
+
+type jsonEnvelope struct {
+	Timestamp string      `json:"timestamp"`
+	Origin    string      `json:"origin"`
+	EventType string      `json:"event_type"`
+	AppId     string      `json:"app_id,omitempty"`
+	Event     interface{} `json:"event"`
+}
+
+func IsValidFormat(format string) bool {
+	for _, validFormat := range Formats {
+		if format == validFormat {
+			return true
+		}
+	}
+	return false
+}
+
+func (formatter EnvelopeFormatter) FormatEnvelope(envelope *events.Envelope) string {
+	timestamp := time.Unix(0, envelope.GetTimestamp())
+	if formatter.UTC {
+		timestamp = timestamp.UTC()
+	} else {
+		timestamp = timestamp.Local()
+	}
+
+	if formatter.Format == logs.FormatJSON {
+		return toJSON(jsonEnvelope{
+			Timestamp: timestamp.Format(time.RFC3339Nano),
+			Origin:    envelope.GetOrigin(),
+			EventType: envelope.GetEventType().String(),
+			AppId:     AppId(envelope),
+			Event:     eventFields(envelope),
+		})
+	}
+
+	timestampLayout := formatter.TimestampLayout
+	if timestampLayout == "" {
+		timestampLayout = logs.DefaultTimestampLayout
+	}
+
+	return fmt.Sprintf("%s [%s] %s %s",
+		formatter.colorize(timestamp.Format(timestampLayout), colors.Cyan),
+		formatter.colorize(envelope.GetOrigin(), colors.Yellow),
+		formatter.colorize(envelope.GetEventType().String(), colors.Bold),
+		eventText(envelope),
+	)
+}
+
+func (formatter EnvelopeFormatter) colorize(output string, color func(string) string) string {
+	if !formatter.Color {
+		return output
+	}
+	return color(output)
+}
+
+func eventText(envelope *events.Envelope) string {
+	switch envelope.GetEventType() {
+	case events.Envelope_LogMessage:
+		log := envelope.GetLogMessage()
+		return fmt.Sprintf("%s [%s|%s] %s", log.GetAppId(), log.GetSourceType(), log.GetSourceInstance(), log.GetMessage())
+	case events.Envelope_ContainerMetric:
+		metric := envelope.GetContainerMetric()
+		return fmt.Sprintf("%s/%d cpu=%.2f%% memory=%d disk=%d", metric.GetApplicationId(), metric.GetInstanceIndex(), metric.GetCpuPercentage(), metric.GetMemoryBytes(), metric.GetDiskBytes())
+	case events.Envelope_ValueMetric:
+		metric := envelope.GetValueMetric()
+		return fmt.Sprintf("%s=%g %s", metric.GetName(), metric.GetValue(), metric.GetUnit())
+	case events.Envelope_CounterEvent:
+		counter := envelope.GetCounterEvent()
+		return fmt.Sprintf("%s delta=%d total=%d", counter.GetName(), counter.GetDelta(), counter.GetTotal())
+	case events.Envelope_HttpStartStop:
+		request := envelope.GetHttpStartStop()
+		duration := time.Duration(request.GetStopTimestamp() - request.GetStartTimestamp())
+		return fmt.Sprintf("%s %s %d %dB %s %s", request.GetMethod(), request.GetUri(), request.GetStatusCode(), request.GetContentLength(), duration, request.GetPeerType())
+	case events.Envelope_Error:
+		envelopeError := envelope.GetError()
+		return fmt.Sprintf("%s %d %s", envelopeError.GetSource(), envelopeError.GetCode(), envelopeError.GetMessage())
+	}
+	return ""
+}
+
+func eventFields(envelope *events.Envelope) map[string]interface{} {
+	switch envelope.GetEventType() {
+	case events.Envelope_LogMessage:
+		log := envelope.GetLogMessage()
+		return map[string]interface{}{
+			"source_type":     log.GetSourceType(),
+			"source_instance": log.GetSourceInstance(),
+			"message_type":    log.GetMessageType().String(),
+			"message":         string(log.GetMessage()),
+		}
+	case events.Envelope_ContainerMetric:
+		metric := envelope.GetContainerMetric()
+		return map[string]interface{}{
+			"instance_index": metric.GetInstanceIndex(),
+			"cpu_percentage": metric.GetCpuPercentage(),
+			"memory_bytes":   metric.GetMemoryBytes(),
+			"disk_bytes":     metric.GetDiskBytes(),
+		}
+	case events.Envelope_ValueMetric:
+		metric := envelope.GetValueMetric()
+		return map[string]interface{}{
+			"name":  metric.GetName(),
+			"value": metric.GetValue(),
+			"unit":  metric.GetUnit(),
+		}
+	case events.Envelope_CounterEvent:
+		counter := envelope.GetCounterEvent()
+		return map[string]interface{}{
+			"name":  counter.GetName(),
+			"delta": counter.GetDelta(),
+			"total": counter.GetTotal(),
+		}
+	case events.Envelope_HttpStartStop:
+		request := envelope.GetHttpStartStop()
+		return map[string]interface{}{
+			"method":         request.GetMethod().String(),
+			"uri":            request.GetUri(),
+			"status_code":    request.GetStatusCode(),
+			"content_length": request.GetContentLength(),
+			"peer_type":      request.GetPeerType().String(),
+			"duration_ns":    request.GetStopTimestamp() - request.GetStartTimestamp(),
+			"remote_address": request.GetRemoteAddress(),
+			"user_agent":     request.GetUserAgent(),
+		}
+	case events.Envelope_Error:
+		envelopeError := envelope.GetError()
+		return map[string]interface{}{
+			"source":  envelopeError.GetSource(),
+			"code":    envelopeError.GetCode(),
+			"message": envelopeError.GetMessage(),
+		}
+	}
+	return map[string]interface{}{}
+}
+
+func toJSON(v interface{}) string {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(jsonBytes)
+}
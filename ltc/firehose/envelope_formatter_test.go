@@ -0,0 +1,106 @@
+package firehose_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/gogo/protobuf/proto"
+)
+
+var _ = Describe("EnvelopeFormatter", func() {
+	var (
+		timestamp int64
+		envelope  *events.Envelope
+	)
+
+	BeforeEach(func() {
+		timestamp = time.Date(2015, time.April, 3, 0, 30, 45, 123456789, time.UTC).UnixNano()
+		envelope = &events.Envelope{
+			Origin:    proto.String("rep"),
+			EventType: events.Envelope_ContainerMetric.Enum(),
+			Timestamp: proto.Int64(timestamp),
+			ContainerMetric: &events.ContainerMetric{
+				ApplicationId: proto.String("my-app"),
+				InstanceIndex: proto.Int32(2),
+				CpuPercentage: proto.Float64(12.5),
+				MemoryBytes:   proto.Uint64(1024),
+				DiskBytes:     proto.Uint64(2048),
+			},
+		}
+	})
+
+	Describe("FormatEnvelope", func() {
+		It("renders text with the timestamp, origin and event", func() {
+			formatter := firehose.EnvelopeFormatter{Format: logs.FormatText, UTC: true}
+
+			Expect(formatter.FormatEnvelope(envelope)).To(Equal("03 Apr 00:30 [rep] ContainerMetric my-app/2 cpu=12.50% memory=1024 disk=2048"))
+		})
+
+		It("colors text when color is enabled", func() {
+			formatter := firehose.EnvelopeFormatter{Format: logs.FormatText, TimestampLayout: time.RFC3339Nano, UTC: true, Color: true}
+
+			Expect(formatter.FormatEnvelope(envelope)).To(Equal(
+				colors.Cyan("2015-04-03T00:30:45.123456789Z") + " [" + colors.Yellow("rep") + "] " + colors.Bold("ContainerMetric") + " my-app/2 cpu=12.50% memory=1024 disk=2048",
+			))
+		})
+
+		It("renders each event type", func() {
+			formatter := firehose.EnvelopeFormatter{Format: logs.FormatText, TimestampLayout: "-"}
+
+			Expect(formatter.FormatEnvelope(&events.Envelope{
+				Origin:     proto.String("rep"),
+				EventType:  events.Envelope_LogMessage.Enum(),
+				LogMessage: &events.LogMessage{AppId: proto.String("my-app"), SourceType: proto.String("APP"), SourceInstance: proto.String("0"), Message: []byte("hello")},
+			})).To(Equal("- [rep] LogMessage my-app [APP|0] hello"))
+
+			Expect(formatter.FormatEnvelope(&events.Envelope{
+				Origin:      proto.String("router"),
+				EventType:   events.Envelope_ValueMetric.Enum(),
+				ValueMetric: &events.ValueMetric{Name: proto.String("latency"), Value: proto.Float64(1.5), Unit: proto.String("ms")},
+			})).To(Equal("- [router] ValueMetric latency=1.5 ms"))
+
+			Expect(formatter.FormatEnvelope(&events.Envelope{
+				Origin:       proto.String("router"),
+				EventType:    events.Envelope_CounterEvent.Enum(),
+				CounterEvent: &events.CounterEvent{Name: proto.String("requests"), Delta: proto.Uint64(2), Total: proto.Uint64(40)},
+			})).To(Equal("- [router] CounterEvent requests delta=2 total=40"))
+
+			Expect(formatter.FormatEnvelope(&events.Envelope{
+				Origin:    proto.String("router"),
+				EventType: events.Envelope_HttpStartStop.Enum(),
+				HttpStartStop: &events.HttpStartStop{
+					StartTimestamp: proto.Int64(0),
+					StopTimestamp:  proto.Int64(int64(15 * time.Millisecond)),
+					Method:         events.Method_GET.Enum(),
+					Uri:            proto.String("/index.html"),
+					StatusCode:     proto.Int32(200),
+					ContentLength:  proto.Int64(512),
+					PeerType:       events.PeerType_Server.Enum(),
+				},
+			})).To(Equal("- [router] HttpStartStop GET /index.html 200 512B 15ms Server"))
+		})
+
+		It("renders json", func() {
+			formatter := firehose.EnvelopeFormatter{Format: logs.FormatJSON, UTC: true, Color: true}
+
+			Expect(formatter.FormatEnvelope(envelope)).To(MatchJSON(`{
+				"timestamp": "2015-04-03T00:30:45.123456789Z",
+				"origin": "rep",
+				"event_type": "ContainerMetric",
+				"app_id": "my-app",
+				"event": {
+					"instance_index": 2,
+					"cpu_percentage": 12.5,
+					"memory_bytes": 1024,
+					"disk_bytes": 2048
+				}
+			}`))
+		})
+	})
+})
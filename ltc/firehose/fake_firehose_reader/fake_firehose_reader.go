@@ -0,0 +1,64 @@
+// This file was generated by counterfeiter
+package fake_firehose_reader
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
+	"github.com/cloudfoundry/noaa/events"
+)
+
+type FakeFirehoseReader struct {
+	TailFirehoseStub        func(subscriptionId string, envelopeCallback func(*events.Envelope), errorCallback func(error))
+	tailFirehoseMutex       sync.RWMutex
+	tailFirehoseArgsForCall []struct {
+		subscriptionId   string
+		envelopeCallback func(*events.Envelope)
+		errorCallback    func(error)
+	}
+	StopTailingStub        func()
+	stopTailingMutex       sync.RWMutex
+	stopTailingArgsForCall []struct{}
+}
+
+func (fake *FakeFirehoseReader) TailFirehose(subscriptionId string, envelopeCallback func(*events.Envelope), errorCallback func(error)) {
+	fake.tailFirehoseMutex.Lock()
+	fake.tailFirehoseArgsForCall = append(fake.tailFirehoseArgsForCall, struct {
+		subscriptionId   string
+		envelopeCallback func(*events.Envelope)
+		errorCallback    func(error)
+	}{subscriptionId, envelopeCallback, errorCallback})
+	fake.tailFirehoseMutex.Unlock()
+	if fake.TailFirehoseStub != nil {
+		fake.TailFirehoseStub(subscriptionId, envelopeCallback, errorCallback)
+	}
+}
+
+func (fake *FakeFirehoseReader) TailFirehoseCallCount() int {
+	fake.tailFirehoseMutex.RLock()
+	defer fake.tailFirehoseMutex.RUnlock()
+	return len(fake.tailFirehoseArgsForCall)
+}
+
+func (fake *FakeFirehoseReader) TailFirehoseArgsForCall(i int) (string, func(*events.Envelope), func(error)) {
+	fake.tailFirehoseMutex.RLock()
+	defer fake.tailFirehoseMutex.RUnlock()
+	return fake.tailFirehoseArgsForCall[i].subscriptionId, fake.tailFirehoseArgsForCall[i].envelopeCallback, fake.tailFirehoseArgsForCall[i].errorCallback
+}
+
+func (fake *FakeFirehoseReader) StopTailing() {
+	fake.stopTailingMutex.Lock()
+	fake.stopTailingArgsForCall = append(fake.stopTailingArgsForCall, struct{}{})
+	fake.stopTailingMutex.Unlock()
+	if fake.StopTailingStub != nil {
+		fake.StopTailingStub()
+	}
+}
+
+func (fake *FakeFirehoseReader) StopTailingCallCount() int {
+	fake.stopTailingMutex.RLock()
+	defer fake.stopTailingMutex.RUnlock()
+	return len(fake.stopTailingArgsForCall)
+}
+
+var _ firehose.FirehoseReader = new(FakeFirehoseReader)
@@ -0,0 +1,64 @@
+package firehose
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/noaa/events"
+)
+
+//go:generate counterfeiter -o fake_firehose_reader/fake_firehose_reader.go . FirehoseReader
+type FirehoseReader interface {
+	TailFirehose(subscriptionId string, envelopeCallback func(*events.Envelope), errorCallback func(error))
+	StopTailing()
+}
+
+type firehoseConsumer interface {
+	Firehose(subscriptionId string, authToken string, outputChan chan<- *events.Envelope, errorChan chan<- error, stopChan chan struct{})
+	Close() error
+}
+
+type firehoseReader struct {
+	consumer firehoseConsumer
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+func NewFirehoseReader(consumer firehoseConsumer) FirehoseReader {
+	return &firehoseReader{
+		consumer: consumer,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// TailFirehose returns once StopTailing is called or the consumer gives up
+// reconnecting. Clients sharing a subscriptionId split the firehose between
+// them.
+func (f *firehoseReader) TailFirehose(subscriptionId string, envelopeCallback func(*events.Envelope), errorCallback func(error)) {
+	outputChan := make(chan *events.Envelope, 10)
+	errorChan := make(chan error, 10)
+
+	go f.consumer.Firehose(subscriptionId, "", outputChan, errorChan, f.stopChan)
+
+	for {
+		select {
+		case <-f.stopChan:
+			return
+		case err, ok := <-errorChan:
+			if !ok {
+				return
+			}
+			if err != nil {
+				errorCallback(err)
+			}
+		case envelope := <-outputChan:
+			envelopeCallback(envelope)
+		}
+	}
+}
+
+func (f *firehoseReader) StopTailing() {
+	f.stopOnce.Do(func() {
+		close(f.stopChan)
+		f.consumer.Close()
+	})
+}
@@ -0,0 +1,121 @@
+package firehose_test
+
+import (
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/firehose"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/gogo/protobuf/proto"
+)
+
+type fakeFirehoseConsumer struct {
+	sync.RWMutex
+	subscriptionId string
+	closed         bool
+	envelopes      chan *events.Envelope
+	errors         chan error
+	giveUp         chan struct{}
+}
+
+func newFakeFirehoseConsumer() *fakeFirehoseConsumer {
+	return &fakeFirehoseConsumer{
+		envelopes: make(chan *events.Envelope),
+		errors:    make(chan error),
+		giveUp:    make(chan struct{}),
+	}
+}
+
+func (consumer *fakeFirehoseConsumer) Firehose(subscriptionId string, authToken string, outputChan chan<- *events.Envelope, errorChan chan<- error, stopChan chan struct{}) {
+	consumer.Lock()
+	consumer.subscriptionId = subscriptionId
+	consumer.Unlock()
+
+	defer close(errorChan)
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-consumer.giveUp:
+			return
+		case err := <-consumer.errors:
+			errorChan <- err
+		case envelope := <-consumer.envelopes:
+			outputChan <- envelope
+		}
+	}
+}
+
+func (consumer *fakeFirehoseConsumer) Close() error {
+	consumer.Lock()
+	defer consumer.Unlock()
+	consumer.closed = true
+	return nil
+}
+
+func (consumer *fakeFirehoseConsumer) SubscriptionId() string {
+	consumer.RLock()
+	defer consumer.RUnlock()
+	return consumer.subscriptionId
+}
+
+func (consumer *fakeFirehoseConsumer) IsClosed() bool {
+	consumer.RLock()
+	defer consumer.RUnlock()
+	return consumer.closed
+}
+
+var _ = Describe("FirehoseReader", func() {
+	var (
+		consumer       *fakeFirehoseConsumer
+		firehoseReader firehose.FirehoseReader
+		envelopes      chan *events.Envelope
+		errs           chan error
+		tailDone       chan struct{}
+	)
+
+	BeforeEach(func() {
+		consumer = newFakeFirehoseConsumer()
+		firehoseReader = firehose.NewFirehoseReader(consumer)
+		envelopes = make(chan *events.Envelope, 10)
+		errs = make(chan error, 10)
+		tailDone = make(chan struct{})
+
+		reader, envelopeChan, errChan, done := firehoseReader, envelopes, errs, tailDone
+		go func() {
+			reader.TailFirehose("my-subscription",
+				func(envelope *events.Envelope) { envelopeChan <- envelope },
+				func(err error) { errChan <- err },
+			)
+			close(done)
+		}()
+	})
+
+	It("subscribes to the firehose with the subscription id", func() {
+		Eventually(consumer.SubscriptionId).Should(Equal("my-subscription"))
+		firehoseReader.StopTailing()
+	})
+
+	It("provides the callbacks with envelopes and errors until StopTailing is called", func() {
+		envelope := &events.Envelope{Origin: proto.String("rep")}
+		consumer.envelopes <- envelope
+		Eventually(envelopes).Should(Receive(Equal(envelope)))
+
+		consumer.errors <- errors.New("connection reset")
+		Eventually(errs).Should(Receive(MatchError("connection reset")))
+
+		firehoseReader.StopTailing()
+
+		Eventually(tailDone).Should(BeClosed())
+		Expect(consumer.IsClosed()).To(BeTrue())
+	})
+
+	It("returns when the consumer gives up reconnecting", func() {
+		close(consumer.giveUp)
+
+		Eventually(tailDone).Should(BeClosed())
+	})
+})
@@ -0,0 +1,30 @@
+package lattice
+
+// Context carries cancellation into Client calls. It is the subset of
+// context.Context (and golang.org/x/net/context.Context) that the client
+// needs, so either can be passed directly.
+type Context interface {
+	Done() <-chan struct{}
+	Err() error
+}
+
+// do runs operation, returning a canceled error as soon as ctx is cancelled.
+// Receptor requests cannot be interrupted, so a cancelled request is left to
+// finish in the background and its result is discarded.
+func do(ctx Context, appName string, operation func() error) error {
+	if err := ctx.Err(); err != nil {
+		return newCanceledError(appName, err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- operation()
+	}()
+
+	select {
+	case err := <-errChan:
+		return wrapError(appName, err)
+	case <-ctx.Done():
+		return newCanceledError(appName, ctx.Err())
+	}
+}
@@ -0,0 +1,89 @@
+package lattice
+
+import (
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/receptor"
+)
+
+type ErrorType string
+
+const (
+	ErrorTypeAppNotFound      ErrorType = "AppNotFound"
+	ErrorTypeAppAlreadyExists ErrorType = "AppAlreadyExists"
+	ErrorTypeInvalidRequest   ErrorType = "InvalidRequest"
+	ErrorTypeUnauthorized     ErrorType = "Unauthorized"
+	ErrorTypePlacement        ErrorType = "PlacementError"
+	ErrorTypeCanceled         ErrorType = "Canceled"
+	ErrorTypeUnknown          ErrorType = "UnknownError"
+)
+
+// Error is returned by every Client method. Cause holds the underlying error
+// from ltc or the receptor, when there is one.
+type Error struct {
+	Type    ErrorType
+	AppName string
+	Message string
+	Cause   error
+}
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+func IsAppNotFound(err error) bool      { return isErrorType(err, ErrorTypeAppNotFound) }
+func IsAppAlreadyExists(err error) bool { return isErrorType(err, ErrorTypeAppAlreadyExists) }
+func IsUnauthorized(err error) bool     { return isErrorType(err, ErrorTypeUnauthorized) }
+func IsPlacementError(err error) bool   { return isErrorType(err, ErrorTypePlacement) }
+func IsCanceled(err error) bool         { return isErrorType(err, ErrorTypeCanceled) }
+
+func isErrorType(err error, errorType ErrorType) bool {
+	latticeErr, ok := err.(*Error)
+	return ok && latticeErr.Type == errorType
+}
+
+func newError(errorType ErrorType, appName, message string, cause error) *Error {
+	return &Error{Type: errorType, AppName: appName, Message: message, Cause: cause}
+}
+
+func newCanceledError(appName string, cause error) *Error {
+	return newError(ErrorTypeCanceled, appName, "operation canceled: "+cause.Error(), cause)
+}
+
+func wrapError(appName string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch typedErr := err.(type) {
+	case *Error:
+		return typedErr
+	case app_examiner.AppNotFoundError, docker_app_runner.AppNotStartedError:
+		return newError(ErrorTypeAppNotFound, appName, err.Error(), err)
+	case docker_app_runner.ExistingAppError:
+		return newError(ErrorTypeAppAlreadyExists, appName, err.Error(), err)
+	case receptor.Error:
+		return newError(receptorErrorType(typedErr), appName, err.Error(), err)
+	}
+
+	switch err.Error() {
+	case docker_app_runner.AttemptedToCreateLatticeDebugErrorMessage:
+		return newError(ErrorTypeInvalidRequest, appName, err.Error(), err)
+	}
+
+	return newError(ErrorTypeUnknown, appName, err.Error(), err)
+}
+
+func receptorErrorType(err receptor.Error) ErrorType {
+	switch err.Type {
+	case receptor.DesiredLRPNotFound, receptor.ActualLRPIndexNotFound:
+		return ErrorTypeAppNotFound
+	case receptor.DesiredLRPAlreadyExists:
+		return ErrorTypeAppAlreadyExists
+	case receptor.InvalidLRP, receptor.InvalidRequest, receptor.InvalidJSON, receptor.InvalidDomain:
+		return ErrorTypeInvalidRequest
+	case receptor.Unauthorized:
+		return ErrorTypeUnauthorized
+	}
+	return ErrorTypeUnknown
+}
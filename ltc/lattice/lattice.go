@@ -0,0 +1,259 @@
+// Package lattice is a Go client for deploying and inspecting apps on a
+// Lattice cluster. It is built from the same components ltc uses, without any
+// of the terminal output, so programs can drive Lattice without shelling out.
+package lattice
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/audited_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/config_helpers"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/persister"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry/noaa"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock"
+)
+
+// PollInterval is how often the wait helpers check on an app.
+const PollInterval = time.Second
+
+// Config identifies the Lattice cluster to talk to. Target is the system
+// domain passed to `ltc target` (e.g., 192.168.11.11.xip.io).
+//
+// Apps created, scaled, re-routed or removed are recorded in the audit log at
+// AuditLogPath and sent to AuditHook, a command or webhook URL as taken by
+// LATTICE_CLI_AUDIT_HOOK, when they are set. OnAuditError is called when an
+// entry cannot be recorded.
+type Config struct {
+	Target   string
+	Username string
+	Password string
+
+	AuditLogPath string
+	AuditHook    string
+	OnAuditError func(error)
+}
+
+// LoadConfig reads the target saved by `ltc target` from the given ltc home
+// directory (usually $HOME or $LATTICE_CLI_HOME), auditing to the same log and
+// hook as ltc.
+func LoadConfig(ltcHome string) (Config, error) {
+	var data config.Data
+	if err := persister.NewFilePersister(config_helpers.ConfigFileLocation(ltcHome)).Load(&data); err != nil {
+		return Config{}, err
+	}
+	if data.Target == "" {
+		return Config{}, errors.New("No target set. Please run ltc target first.")
+	}
+
+	return Config{
+		Target:       data.Target,
+		Username:     data.Username,
+		Password:     data.Password,
+		AuditLogPath: config_helpers.AuditLogFileLocation(ltcHome),
+		AuditHook:    os.Getenv(audit.HookVar),
+	}, nil
+}
+
+// Client deploys and inspects apps on a Lattice cluster. Every method honours
+// cancellation of its Context and returns *Error values for failures.
+type Client interface {
+	CreateApp(ctx Context, params docker_app_runner.CreateDockerAppParams) error
+	ScaleApp(ctx Context, name string, instances int) error
+	UpdateAppRoutes(ctx Context, name string, routes docker_app_runner.RouteOverrides) error
+	RemoveApp(ctx Context, name string) error
+
+	ListApps(ctx Context) ([]app_examiner.AppInfo, error)
+	AppStatus(ctx Context, name string) (app_examiner.AppInfo, error)
+	ListCells(ctx Context) ([]app_examiner.CellInfo, error)
+
+	// TailLogs streams log messages for the app until ctx is cancelled.
+	TailLogs(ctx Context, name string, logCallback func(*events.LogMessage), errorCallback func(error)) error
+
+	// WaitForAppRunning blocks until the app has the given number of running
+	// instances, one of them fails to be placed, the app cannot be found or
+	// checked on, or ctx is cancelled.
+	WaitForAppRunning(ctx Context, name string, instances int) error
+	// WaitForAppRemoved blocks until no instances of the app remain, they
+	// cannot be checked on, or ctx is cancelled.
+	WaitForAppRemoved(ctx Context, name string) error
+}
+
+type client struct {
+	appRunner        docker_app_runner.AppRunner
+	appExaminer      app_examiner.AppExaminer
+	logReaderFactory func() logs.LogReader
+	clock            clock.Clock
+}
+
+// New returns a Client for the cluster described by latticeConfig.
+func New(latticeConfig Config) Client {
+	ltcConfig := config.New(persister.NewMemPersister())
+	ltcConfig.SetTarget(latticeConfig.Target)
+	ltcConfig.SetLogin(latticeConfig.Username, latticeConfig.Password)
+
+	receptorClient := receptor.NewClient(ltcConfig.Receptor())
+	clock := clock.NewClock()
+
+	appRunner := docker_app_runner.New(receptorClient, ltcConfig.Target())
+	if latticeConfig.AuditLogPath != "" || latticeConfig.AuditHook != "" {
+		auditor := audit.New(latticeConfig.AuditLogPath, ltcConfig, audit.NewHook(latticeConfig.AuditHook), clock)
+		appRunner = audited_app_runner.New(appRunner, auditor, audit.SourceClient, latticeConfig.OnAuditError)
+	}
+
+	return NewClient(
+		appRunner,
+		app_examiner.New(receptorClient),
+		func() logs.LogReader {
+			return logs.NewLogReader(noaa.NewConsumer(config_helpers.LoggregatorUrl(ltcConfig.Loggregator()), nil, nil))
+		},
+		clock,
+	)
+}
+
+// NewClient builds a Client from already constructed ltc components.
+func NewClient(appRunner docker_app_runner.AppRunner, appExaminer app_examiner.AppExaminer, logReaderFactory func() logs.LogReader, clock clock.Clock) Client {
+	return &client{
+		appRunner:        appRunner,
+		appExaminer:      appExaminer,
+		logReaderFactory: logReaderFactory,
+		clock:            clock,
+	}
+}
+
+func (c *client) CreateApp(ctx Context, params docker_app_runner.CreateDockerAppParams) error {
+	return do(ctx, params.Name, func() error {
+		return c.appRunner.CreateDockerApp(params)
+	})
+}
+
+func (c *client) ScaleApp(ctx Context, name string, instances int) error {
+	return do(ctx, name, func() error {
+		return c.appRunner.ScaleApp(name, instances)
+	})
+}
+
+func (c *client) UpdateAppRoutes(ctx Context, name string, routes docker_app_runner.RouteOverrides) error {
+	return do(ctx, name, func() error {
+		return c.appRunner.UpdateAppRoutes(name, routes)
+	})
+}
+
+func (c *client) RemoveApp(ctx Context, name string) error {
+	return do(ctx, name, func() error {
+		return c.appRunner.RemoveApp(name)
+	})
+}
+
+func (c *client) ListApps(ctx Context) ([]app_examiner.AppInfo, error) {
+	var apps []app_examiner.AppInfo
+	err := do(ctx, "", func() (err error) {
+		apps, err = c.appExaminer.ListApps()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (c *client) AppStatus(ctx Context, name string) (app_examiner.AppInfo, error) {
+	var appInfo app_examiner.AppInfo
+	err := do(ctx, name, func() (err error) {
+		appInfo, err = c.appExaminer.AppStatus(name)
+		return err
+	})
+	if err != nil {
+		return app_examiner.AppInfo{}, err
+	}
+	return appInfo, nil
+}
+
+func (c *client) ListCells(ctx Context) ([]app_examiner.CellInfo, error) {
+	var cells []app_examiner.CellInfo
+	err := do(ctx, "", func() (err error) {
+		cells, err = c.appExaminer.ListCells()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cells, nil
+}
+
+func (c *client) TailLogs(ctx Context, name string, logCallback func(*events.LogMessage), errorCallback func(error)) error {
+	if err := ctx.Err(); err != nil {
+		return newCanceledError(name, err)
+	}
+
+	logReader := c.logReaderFactory()
+	tailingDone := make(chan struct{})
+	go func() {
+		logReader.TailLogs(name, logCallback, errorCallback)
+		close(tailingDone)
+	}()
+
+	select {
+	case <-ctx.Done():
+		logReader.StopTailing()
+		<-tailingDone
+	case <-tailingDone:
+	}
+
+	return nil
+}
+
+func (c *client) WaitForAppRunning(ctx Context, name string, instances int) error {
+	return c.pollUntil(ctx, name, func() (bool, error) {
+		runningInstances, placementError, err := c.appRunner.RunningAppInstancesInfo(name)
+		if err != nil {
+			return false, err
+		}
+		if placementError {
+			return false, newError(ErrorTypePlacement, name, "could not place all instances of "+name, nil)
+		}
+		if runningInstances == instances {
+			return true, nil
+		}
+		if runningInstances == 0 {
+			if _, err := c.appExaminer.AppStatus(name); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	})
+}
+
+func (c *client) WaitForAppRemoved(ctx Context, name string) error {
+	return c.pollUntil(ctx, name, func() (bool, error) {
+		exists, err := c.appRunner.AppExists(name)
+		return err == nil && !exists, err
+	})
+}
+
+func (c *client) pollUntil(ctx Context, name string, condition func() (bool, error)) error {
+	for {
+		var done bool
+		err := do(ctx, name, func() (err error) {
+			done, err = condition()
+			return err
+		})
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return newCanceledError(name, ctx.Err())
+		case <-c.clock.NewTimer(PollInterval).C():
+		}
+	}
+}
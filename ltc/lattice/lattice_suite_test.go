@@ -0,0 +1,13 @@
+package lattice_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLattice(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lattice Suite")
+}
@@ -0,0 +1,375 @@
+package lattice_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/lattice"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs/fake_log_reader"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock/fakeclock"
+)
+
+type testContext struct {
+	done chan struct{}
+	once sync.Once
+	lock sync.Mutex
+	err  error
+}
+
+func newTestContext() *testContext {
+	return &testContext{done: make(chan struct{})}
+}
+
+func (c *testContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *testContext) Err() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err
+}
+
+func (c *testContext) Cancel() {
+	c.once.Do(func() {
+		c.lock.Lock()
+		c.err = errors.New("context canceled")
+		c.lock.Unlock()
+		close(c.done)
+	})
+}
+
+var _ = Describe("Client", func() {
+	var (
+		appRunner   *fake_app_runner.FakeAppRunner
+		appExaminer *fake_app_examiner.FakeAppExaminer
+		logReader   *fake_log_reader.FakeLogReader
+		clock       *fakeclock.FakeClock
+		ctx         *testContext
+		client      lattice.Client
+	)
+
+	BeforeEach(func() {
+		appRunner = &fake_app_runner.FakeAppRunner{}
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		logReader = fake_log_reader.NewFakeLogReader()
+		clock = fakeclock.NewFakeClock(time.Now())
+		ctx = newTestContext()
+
+		client = lattice.NewClient(appRunner, appExaminer, func() logs.LogReader { return logReader }, clock)
+	})
+
+	Describe("CreateApp", func() {
+		It("creates the app with the app runner", func() {
+			params := docker_app_runner.CreateDockerAppParams{Name: "cool-web-app", Instances: 2}
+
+			Expect(client.CreateApp(ctx, params)).To(Succeed())
+
+			Expect(appRunner.CreateDockerAppCallCount()).To(Equal(1))
+			Expect(appRunner.CreateDockerAppArgsForCall(0)).To(Equal(params))
+		})
+
+		It("returns a typed error when the app already exists", func() {
+			appRunner.CreateDockerAppReturns(docker_app_runner.ExistingAppError("cool-web-app"))
+
+			err := client.CreateApp(ctx, docker_app_runner.CreateDockerAppParams{Name: "cool-web-app"})
+
+			Expect(lattice.IsAppAlreadyExists(err)).To(BeTrue())
+			Expect(err.(*lattice.Error).AppName).To(Equal("cool-web-app"))
+			Expect(err).To(MatchError("App cool-web-app, is already running"))
+		})
+
+		It("does not call the app runner when the context is already cancelled", func() {
+			ctx.Cancel()
+
+			err := client.CreateApp(ctx, docker_app_runner.CreateDockerAppParams{Name: "cool-web-app"})
+
+			Expect(lattice.IsCanceled(err)).To(BeTrue())
+			Expect(appRunner.CreateDockerAppCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("ScaleApp", func() {
+		It("scales the app with the app runner", func() {
+			Expect(client.ScaleApp(ctx, "cool-web-app", 5)).To(Succeed())
+
+			name, instances := appRunner.ScaleAppArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(instances).To(Equal(5))
+		})
+
+		It("returns a typed error when the app does not exist", func() {
+			appRunner.ScaleAppReturns(docker_app_runner.AppNotStartedError("cool-web-app"))
+
+			Expect(lattice.IsAppNotFound(client.ScaleApp(ctx, "cool-web-app", 5))).To(BeTrue())
+		})
+
+		It("returns a canceled error without waiting for the request in flight when the context is cancelled during it", func() {
+			requestStarted := make(chan struct{})
+			releaseRequest := make(chan struct{})
+			appRunner.ScaleAppStub = func(string, int) error {
+				close(requestStarted)
+				<-releaseRequest
+				return nil
+			}
+
+			errChan := make(chan error, 1)
+			go func() { errChan <- client.ScaleApp(ctx, "cool-web-app", 5) }()
+
+			Eventually(requestStarted).Should(BeClosed())
+			ctx.Cancel()
+
+			var err error
+			Eventually(errChan).Should(Receive(&err))
+			Expect(lattice.IsCanceled(err)).To(BeTrue())
+			close(releaseRequest)
+		})
+	})
+
+	Describe("UpdateAppRoutes", func() {
+		It("updates the routes with the app runner", func() {
+			routes := docker_app_runner.RouteOverrides{{HostnamePrefix: "foo", Port: 8080}}
+
+			Expect(client.UpdateAppRoutes(ctx, "cool-web-app", routes)).To(Succeed())
+
+			name, actualRoutes := appRunner.UpdateAppRoutesArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(actualRoutes).To(Equal(routes))
+		})
+	})
+
+	Describe("RemoveApp", func() {
+		It("maps receptor errors to typed errors", func() {
+			appRunner.RemoveAppReturns(receptor.Error{Type: receptor.Unauthorized, Message: "not allowed"})
+
+			err := client.RemoveApp(ctx, "cool-web-app")
+
+			Expect(lattice.IsUnauthorized(err)).To(BeTrue())
+			Expect(err.(*lattice.Error).Cause).To(Equal(receptor.Error{Type: receptor.Unauthorized, Message: "not allowed"}))
+		})
+
+		It("wraps unrecognised errors", func() {
+			appRunner.RemoveAppReturns(errors.New("boom"))
+
+			err := client.RemoveApp(ctx, "cool-web-app")
+
+			Expect(err.(*lattice.Error).Type).To(Equal(lattice.ErrorTypeUnknown))
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Describe("examining apps and cells", func() {
+		It("lists apps", func() {
+			appExaminer.ListAppsReturns([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app"}}, nil)
+
+			apps, err := client.ListApps(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]app_examiner.AppInfo{{ProcessGuid: "cool-web-app"}}))
+		})
+
+		It("lists cells", func() {
+			appExaminer.ListCellsReturns([]app_examiner.CellInfo{{CellID: "cell-1"}}, nil)
+
+			cells, err := client.ListCells(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cells).To(Equal([]app_examiner.CellInfo{{CellID: "cell-1"}}))
+		})
+
+		It("returns the app status", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{ProcessGuid: "cool-web-app", DesiredInstances: 3}, nil)
+
+			appInfo, err := client.AppStatus(ctx, "cool-web-app")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(appInfo.DesiredInstances).To(Equal(3))
+			Expect(appExaminer.AppStatusArgsForCall(0)).To(Equal("cool-web-app"))
+		})
+
+		It("returns a typed error when the app is not found", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			_, err := client.AppStatus(ctx, "cool-web-app")
+
+			Expect(lattice.IsAppNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("TailLogs", func() {
+		It("passes log messages and errors to the callbacks", func() {
+			logReader.AddLog(&events.LogMessage{Message: []byte("hello")})
+			logReader.AddError(errors.New("websocket hiccup"))
+
+			var messages []string
+			var errs []error
+			err := client.TailLogs(ctx, "cool-web-app", func(logMessage *events.LogMessage) {
+				messages = append(messages, string(logMessage.GetMessage()))
+			}, func(err error) {
+				errs = append(errs, err)
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(Equal([]string{"hello"}))
+			Expect(errs).To(ConsistOf(MatchError("websocket hiccup")))
+			Expect(logReader.GetAppGuid()).To(Equal("cool-web-app"))
+		})
+	})
+
+	Describe("WaitForAppRunning", func() {
+		It("polls until the requested number of instances are running", func() {
+			var lock sync.Mutex
+			runningInstances := 1
+			appRunner.RunningAppInstancesInfoStub = func(string) (int, bool, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				return runningInstances, false, nil
+			}
+
+			errChan := make(chan error, 1)
+			go func() { errChan <- client.WaitForAppRunning(ctx, "cool-web-app", 2) }()
+
+			Eventually(appRunner.RunningAppInstancesInfoCallCount).Should(Equal(1))
+			Consistently(errChan).ShouldNot(Receive())
+
+			lock.Lock()
+			runningInstances = 2
+			lock.Unlock()
+			Eventually(func() int {
+				clock.Increment(lattice.PollInterval)
+				return appRunner.RunningAppInstancesInfoCallCount()
+			}).Should(BeNumerically(">=", 2))
+
+			Eventually(errChan).Should(Receive(BeNil()))
+		})
+
+		It("returns a placement error when instances cannot be placed", func() {
+			appRunner.RunningAppInstancesInfoReturns(0, true, nil)
+
+			err := client.WaitForAppRunning(ctx, "cool-web-app", 2)
+
+			Expect(lattice.IsPlacementError(err)).To(BeTrue())
+		})
+
+		It("returns a typed error when the app does not exist", func() {
+			appRunner.RunningAppInstancesInfoReturns(0, false, nil)
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			err := client.WaitForAppRunning(ctx, "cool-web-app", 2)
+
+			Expect(lattice.IsAppNotFound(err)).To(BeTrue())
+			Expect(appExaminer.AppStatusArgsForCall(0)).To(Equal("cool-web-app"))
+		})
+
+		It("returns a typed error when the receptor cannot be queried", func() {
+			appRunner.RunningAppInstancesInfoReturns(0, false, receptor.Error{Type: receptor.Unauthorized, Message: "unauthorized"})
+
+			err := client.WaitForAppRunning(ctx, "cool-web-app", 2)
+
+			Expect(lattice.IsUnauthorized(err)).To(BeTrue())
+		})
+
+		It("returns unknown errors instead of polling forever", func() {
+			appRunner.RunningAppInstancesInfoReturns(0, false, errors.New("connection refused"))
+
+			err := client.WaitForAppRunning(ctx, "cool-web-app", 2)
+
+			Expect(err).To(MatchError("connection refused"))
+			Expect(err.(*lattice.Error).Type).To(Equal(lattice.ErrorTypeUnknown))
+		})
+
+		It("gives up when the context is cancelled", func() {
+			appRunner.RunningAppInstancesInfoReturns(0, false, nil)
+
+			errChan := make(chan error, 1)
+			go func() { errChan <- client.WaitForAppRunning(ctx, "cool-web-app", 2) }()
+
+			Eventually(appRunner.RunningAppInstancesInfoCallCount).Should(Equal(1))
+			ctx.Cancel()
+
+			var err error
+			Eventually(errChan).Should(Receive(&err))
+			Expect(lattice.IsCanceled(err)).To(BeTrue())
+		})
+	})
+
+	Describe("WaitForAppRemoved", func() {
+		It("returns once the app no longer exists", func() {
+			appRunner.AppExistsReturns(false, nil)
+
+			Expect(client.WaitForAppRemoved(ctx, "cool-web-app")).To(Succeed())
+			Expect(appRunner.AppExistsArgsForCall(0)).To(Equal("cool-web-app"))
+		})
+
+		It("returns a typed error when the receptor cannot be queried", func() {
+			appRunner.AppExistsReturns(false, receptor.Error{Type: receptor.Unauthorized, Message: "unauthorized"})
+
+			err := client.WaitForAppRemoved(ctx, "cool-web-app")
+
+			Expect(lattice.IsUnauthorized(err)).To(BeTrue())
+		})
+	})
+
+	Describe("LoadConfig", func() {
+		var ltcHome string
+
+		BeforeEach(func() {
+			var err error
+			ltcHome, err = ioutil.TempDir("", "ltc-home")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(ltcHome)
+		})
+
+		It("reads the target saved by ltc target", func() {
+			Expect(os.MkdirAll(filepath.Join(ltcHome, ".lattice"), 0700)).To(Succeed())
+			configJSON := `{"Target":"192.168.11.11.xip.io","Username":"user","Password":"pass"}`
+			Expect(ioutil.WriteFile(filepath.Join(ltcHome, ".lattice", "config.json"), []byte(configJSON), 0600)).To(Succeed())
+
+			config, err := lattice.LoadConfig(ltcHome)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(Equal(lattice.Config{
+				Target:       "192.168.11.11.xip.io",
+				Username:     "user",
+				Password:     "pass",
+				AuditLogPath: filepath.Join(ltcHome, ".lattice", "audit.log"),
+			}))
+		})
+
+		It("audits to the hook set for ltc", func() {
+			Expect(os.MkdirAll(filepath.Join(ltcHome, ".lattice"), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(ltcHome, ".lattice", "config.json"), []byte(`{"Target":"192.168.11.11.xip.io"}`), 0600)).To(Succeed())
+			os.Setenv(audit.HookVar, "https://audit.example.com")
+			defer os.Unsetenv(audit.HookVar)
+
+			config, err := lattice.LoadConfig(ltcHome)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AuditHook).To(Equal("https://audit.example.com"))
+		})
+
+		It("returns an error when no target has been set", func() {
+			_, err := lattice.LoadConfig(ltcHome)
+
+			Expect(err).To(MatchError("No target set. Please run ltc target first."))
+		})
+	})
+})
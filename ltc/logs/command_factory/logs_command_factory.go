@@ -1,61 +1,402 @@
 package command_factory
 
 import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/reserved_app_ids"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/cloudfoundry/noaa/events"
 	"github.com/codegangsta/cli"
 )
 
+var reconnectTimeoutFlag = cli.DurationFlag{
+	Name:  "reconnect-timeout",
+	Usage: "Exit with an error once the log stream has been disconnected this long (e.g., 30s). By default, exit when reconnecting gives up",
+}
+
 type logsCommandFactory struct {
+	appExaminer         app_examiner.AppExaminer
 	ui                  terminal.UI
 	tailedLogsOutputter console_tailed_logs_outputter.TailedLogsOutputter
 	exitHandler         exit_handler.ExitHandler
+	isTerminal          bool
 }
 
-func NewLogsCommandFactory(ui terminal.UI, tailedLogsOutputter console_tailed_logs_outputter.TailedLogsOutputter, exitHandler exit_handler.ExitHandler) *logsCommandFactory {
+// NewLogsCommandFactory takes whether the UI writes to a terminal, and colors
+// text output only when it does.
+func NewLogsCommandFactory(appExaminer app_examiner.AppExaminer, ui terminal.UI, tailedLogsOutputter console_tailed_logs_outputter.TailedLogsOutputter, exitHandler exit_handler.ExitHandler, isTerminal bool) *logsCommandFactory {
 	return &logsCommandFactory{
+		appExaminer:         appExaminer,
 		ui:                  ui,
 		tailedLogsOutputter: tailedLogsOutputter,
 		exitHandler:         exitHandler,
+		isTerminal:          isTerminal,
 	}
 }
 
 func (factory *logsCommandFactory) MakeLogsCommand() cli.Command {
+	var logsFlags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  "all, a",
+			Usage: "Tail the logs of every app",
+		},
+		cli.StringFlag{
+			Name:  "selector",
+			Usage: "Tail the logs of every app whose name matches this glob pattern, or that has the environment variable KEY=VALUE",
+		},
+		cli.StringSliceFlag{
+			Name:  "source, s",
+			Usage: "Only show logs from this source type, e.g. APP or HEALTH (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "instance, i",
+			Usage: "Only show logs from this instance index (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.BoolFlag{
+			Name:  "stdout",
+			Usage: "Only show messages written to stdout",
+		},
+		cli.BoolFlag{
+			Name:  "stderr",
+			Usage: "Only show messages written to stderr",
+		},
+		cli.StringFlag{
+			Name:  "include",
+			Usage: "Only show messages matching this regular expression, highlighting the matches",
+		},
+		cli.StringFlag{
+			Name:  "exclude",
+			Usage: "Hide messages matching this regular expression",
+		},
+		cli.StringFlag{
+			Name:  "highlight",
+			Usage: "Highlight matches of this regular expression instead of --include",
+		},
+		cli.StringFlag{
+			Name:  "format, f",
+			Usage: "Output format: text, json or raw",
+			Value: logs.FormatText,
+		},
+		cli.StringFlag{
+			Name:  "timestamp-format",
+			Usage: "Timestamp layout for text output: short, rfc3339 (with nanoseconds) or a Go time layout",
+			Value: logs.TimestampFormatShort,
+		},
+		cli.BoolFlag{
+			Name:  "utc",
+			Usage: "Show timestamps in UTC instead of local time",
+		},
+		reconnectTimeoutFlag,
+	}
+	logsFlags = append(logsFlags, logs.OutputFlags("logs")...)
+
 	var logsCommand = cli.Command{
-		Name:        "logs",
-		ShortName:   "lo",
-		Usage:       "Streams logs from the specified application",
-		Description: "ltc logs APP_NAME",
-		Action:      factory.tailLogs,
-		Flags:       []cli.Flag{},
+		Name:      "logs",
+		ShortName: "lo",
+		Usage:     "Streams logs from the specified applications",
+		Description: `ltc logs [--source=TYPE] [--instance=INDEX] [--stdout|--stderr] [--include=REGEX] [--exclude=REGEX] [--format=text|json|raw] [--output=FILE] (APP_NAME... | --all | --selector=SELECTOR)
+
+   e.g. to follow instance 3 of an app, hiding health checks:
+   		ltc logs --source=APP --instance=3 my-app
+
+   Logs from several apps are merged in timestamp order, each line prefixed with its app name:
+   		ltc logs web-app worker-app
+   		ltc logs --selector='web-*'
+   		ltc logs --selector=TEAM=payments
+
+   Colors are disabled when output is not a terminal. Lost connections and
+   dropped messages are reported on stderr.`,
+		Action: factory.tailLogs,
+		Flags:  logsFlags,
 	}
 
 	return logsCommand
 }
 
 func (factory *logsCommandFactory) MakeDebugLogsCommand() cli.Command {
+	var debugLogsFlags = []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "component, c",
+			Usage: "Only show logs from this component, e.g. rep or executor (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "cell",
+			Usage: "Only show logs from this cell, e.g. lattice-cell-01 (can be passed multiple times)",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringFlag{
+			Name:  "level, l",
+			Usage: "Only show lager entries at or above this level: debug, info, error or fatal",
+		},
+		cli.StringFlag{
+			Name:  "session",
+			Usage: "Only show lager entries from this session and its nested sessions, e.g. 7.2",
+		},
+		cli.StringFlag{
+			Name:  "format, f",
+			Usage: "Output format: text, json or raw",
+			Value: logs.FormatText,
+		},
+		cli.StringFlag{
+			Name:  "timestamp-format",
+			Usage: "Timestamp layout for text output: short, rfc3339 (with nanoseconds) or a Go time layout",
+			Value: logs.TimestampFormatShort,
+		},
+		cli.BoolFlag{
+			Name:  "utc",
+			Usage: "Show timestamps in UTC instead of local time",
+		},
+		reconnectTimeoutFlag,
+	}
+
 	return cli.Command{
-		Name:        "debug-logs",
-		ShortName:   "dl",
-		Usage:       "Streams logs from the lattice cluster components",
-		Description: "ltc debug-logs",
-		Action:      factory.tailDebugLogs,
+		Name:      "debug-logs",
+		ShortName: "dl",
+		Usage:     "Streams logs from the lattice cluster components",
+		Description: `ltc debug-logs [--component=NAME] [--cell=CELL_ID] [--level=LEVEL] [--session=SESSION] [--format=text|json|raw]
+
+   Lager entries are parsed and shown with their level, session and data.
+
+   e.g. to follow the errors of one rep session:
+   		ltc debug-logs --component=rep --cell=lattice-cell-01 --session=7 --level=error`,
+		Action: factory.tailDebugLogs,
+		Flags:  debugLogsFlags,
 	}
 }
 
 func (factory *logsCommandFactory) tailLogs(context *cli.Context) {
-	appGuid := context.Args().First()
+	appGuids := []string(context.Args())
+	all, selector := context.Bool("all"), context.String("selector")
 
-	if appGuid == "" {
+	if len(appGuids) == 0 && !all && selector == "" {
 		factory.ui.IncorrectUsage("")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	if (len(appGuids) > 0 && (all || selector != "")) || (all && selector != "") {
+		factory.ui.IncorrectUsage("Pass either app names, --all or --selector")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	if _, err := path.Match(selector, ""); err != nil {
+		factory.ui.IncorrectUsage(fmt.Sprintf("Invalid --selector pattern: %s", selector))
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	filter, err := logFilterFromFlags(context)
+	if err != nil {
+		factory.ui.IncorrectUsage(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
 		return
 	}
 
-	factory.tailedLogsOutputter.OutputTailedLogs(appGuid)
+	formatter, err := factory.logFormatterFromFlags(context)
+	if err != nil {
+		factory.ui.IncorrectUsage(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	merged := len(appGuids) > 1
+	if all || selector != "" {
+		appGuids, err = factory.selectApps(selector)
+		if err != nil {
+			factory.ui.SayError(fmt.Sprintf("Error listing apps: %s", err))
+			factory.exitHandler.Exit(exit_codes.GeneralError)
+			return
+		}
+		if len(appGuids) == 0 {
+			factory.ui.SayError("No apps to tail.")
+			factory.exitHandler.Exit(exit_codes.AppNotFound)
+			return
+		}
+		merged = true
+	}
+
+	if context.Duration("reconnect-timeout") < 0 {
+		factory.ui.IncorrectUsage("--reconnect-timeout must not be negative")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	options := console_tailed_logs_outputter.OutputOptions{
+		Filter:           filter,
+		Formatter:        formatter,
+		ReconnectTimeout: context.Duration("reconnect-timeout"),
+	}
+
+	outputFile, ok := logs.OpenOutputFile(context, factory.ui, factory.exitHandler)
+	if !ok {
+		return
+	}
+	if outputFile != nil {
+		defer outputFile.Close()
+
+		options.Writer = outputFile
+		factory.ui.SayLine(fmt.Sprintf("Writing logs for %s to %s", strings.Join(appGuids, ", "), context.String("output")))
+	}
+
+	if merged {
+		err = factory.tailedLogsOutputter.OutputMergedTailedLogs(appGuids, options)
+	} else {
+		err = factory.tailedLogsOutputter.OutputTailedLogsWithOptions(appGuids[0], options)
+	}
+	factory.exitOnStreamLost(err)
+}
+
+// exitOnStreamLost handles the error returned by the outputter, which is only
+// ever a StreamLostError.
+func (factory *logsCommandFactory) exitOnStreamLost(err error) {
+	if err != nil {
+		factory.ui.SayError(err.Error())
+		factory.exitHandler.Exit(exit_codes.LogStreamLost)
+	}
+}
+
+// selectApps returns the names of all apps, or of those matching selector.
+func (factory *logsCommandFactory) selectApps(selector string) ([]string, error) {
+	appList, err := factory.appExaminer.ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	appGuids := []string{}
+	for _, app := range appList {
+		if selector == "" || appMatchesSelector(app, selector) {
+			appGuids = append(appGuids, app.ProcessGuid)
+		}
+	}
+	sort.Strings(appGuids)
+
+	return appGuids, nil
+}
+
+func appMatchesSelector(app app_examiner.AppInfo, selector string) bool {
+	if nameAndValue := strings.SplitN(selector, "=", 2); len(nameAndValue) == 2 {
+		for _, envVar := range app.EnvironmentVariables {
+			if envVar.Name == nameAndValue[0] && envVar.Value == nameAndValue[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	matched, _ := path.Match(selector, app.ProcessGuid)
+	return matched
+}
+
+func (factory *logsCommandFactory) logFormatterFromFlags(context *cli.Context) (logs.LogFormatter, error) {
+	formatter := logs.LogFormatter{
+		Format:          context.String("format"),
+		TimestampLayout: logs.TimestampLayout(context.String("timestamp-format")),
+		UTC:             context.Bool("utc"),
+		Color:           factory.isTerminal && context.String("output") == "",
+	}
+
+	if !logs.IsValidFormat(formatter.Format) {
+		return logs.LogFormatter{}, fmt.Errorf("Unknown format: %s. Supported formats are: %s", formatter.Format, strings.Join(logs.Formats, ", "))
+	}
+
+	return formatter, nil
+}
+
+func logFilterFromFlags(context *cli.Context) (logs.LogFilter, error) {
+	filter := logs.LogFilter{
+		SourceTypes: context.StringSlice("source"),
+		Instances:   context.StringSlice("instance"),
+	}
+
+	for _, instance := range filter.Instances {
+		if index, err := strconv.Atoi(instance); err != nil || index < 0 {
+			return logs.LogFilter{}, fmt.Errorf("Instance must be a non-negative integer: %s", instance)
+		}
+	}
+
+	if context.Bool("stdout") != context.Bool("stderr") {
+		if context.Bool("stdout") {
+			filter.MessageTypes = []events.LogMessage_MessageType{events.LogMessage_OUT}
+		} else {
+			filter.MessageTypes = []events.LogMessage_MessageType{events.LogMessage_ERR}
+		}
+	}
+
+	var err error
+	if filter.Include, err = compileFlag(context, "include"); err != nil {
+		return logs.LogFilter{}, err
+	}
+	if filter.Exclude, err = compileFlag(context, "exclude"); err != nil {
+		return logs.LogFilter{}, err
+	}
+	if filter.Highlight, err = compileFlag(context, "highlight"); err != nil {
+		return logs.LogFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func compileFlag(context *cli.Context, flagName string) (*regexp.Regexp, error) {
+	expression := context.String(flagName)
+	if expression == "" {
+		return nil, nil
+	}
+
+	compiled, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid --%s expression: %s", flagName, err)
+	}
+	return compiled, nil
 }
 
 func (factory *logsCommandFactory) tailDebugLogs(context *cli.Context) {
-	factory.tailedLogsOutputter.OutputTailedLogs(reserved_app_ids.LatticeDebugLogStreamAppId)
+	filter := logs.LogFilter{
+		SourceTypes: context.StringSlice("component"),
+		Instances:   context.StringSlice("cell"),
+		Session:     context.String("session"),
+	}
+
+	if level := context.String("level"); level != "" {
+		minLevel, err := logs.ParseLogLevel(level)
+		if err != nil {
+			factory.ui.IncorrectUsage(err.Error())
+			factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+			return
+		}
+		filter.MinLevel = minLevel
+	}
+
+	formatter, err := factory.logFormatterFromFlags(context)
+	if err != nil {
+		factory.ui.IncorrectUsage(err.Error())
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+	formatter.Lager = true
+
+	if context.Duration("reconnect-timeout") < 0 {
+		factory.ui.IncorrectUsage("--reconnect-timeout must not be negative")
+		factory.exitHandler.Exit(exit_codes.InvalidSyntax)
+		return
+	}
+
+	factory.exitOnStreamLost(factory.tailedLogsOutputter.OutputTailedLogsWithOptions(reserved_app_ids.LatticeDebugLogStreamAppId, console_tailed_logs_outputter.OutputOptions{
+		Filter:           filter,
+		Formatter:        formatter,
+		ReconnectTimeout: context.Duration("reconnect-timeout"),
+	}))
 }
@@ -1,20 +1,30 @@
 package command_factory_test
 
 import (
+	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 
-	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/fake_exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/command_factory"
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter/fake_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/reserved_app_ids"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
+	"github.com/cloudfoundry/noaa/events"
 	"github.com/codegangsta/cli"
+	"github.com/pivotal-golang/lager"
 )
 
 var _ = Describe("CommandFactory", func() {
@@ -23,15 +33,17 @@ var _ = Describe("CommandFactory", func() {
 		terminalUI              terminal.UI
 		fakeTailedLogsOutputter *fake_tailed_logs_outputter.FakeTailedLogsOutputter
 		signalChan              chan os.Signal
-		exitHandler             exit_handler.ExitHandler
+		exitHandler             *fake_exit_handler.FakeExitHandler
+		fakeAppExaminer         *fake_app_examiner.FakeAppExaminer
 	)
 
 	BeforeEach(func() {
 		outputBuffer = gbytes.NewBuffer()
-		terminalUI = terminal.NewUI(nil, outputBuffer, nil)
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
 		fakeTailedLogsOutputter = fake_tailed_logs_outputter.NewFakeTailedLogsOutputter()
 		signalChan = make(chan os.Signal)
 		exitHandler = &fake_exit_handler.FakeExitHandler{}
+		fakeAppExaminer = &fake_app_examiner.FakeAppExaminer{}
 	})
 
 	Describe("LogsCommand", func() {
@@ -39,7 +51,7 @@ var _ = Describe("CommandFactory", func() {
 		var logsCommand cli.Command
 
 		BeforeEach(func() {
-			commandFactory := command_factory.NewLogsCommandFactory(terminalUI, fakeTailedLogsOutputter, exitHandler)
+			commandFactory := command_factory.NewLogsCommandFactory(fakeAppExaminer, terminalUI, fakeTailedLogsOutputter, exitHandler, true)
 			logsCommand = commandFactory.MakeLogsCommand()
 		})
 
@@ -50,15 +62,269 @@ var _ = Describe("CommandFactory", func() {
 
 			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, args)
 
-			Eventually(fakeTailedLogsOutputter.OutputTailedLogsCallCount).Should(Equal(1))
-			Expect(fakeTailedLogsOutputter.OutputTailedLogsArgsForCall(0)).To(Equal("my-app-guid"))
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			appGuid, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(appGuid).To(Equal("my-app-guid"))
+			Expect(options.Filter).To(Equal(logs.LogFilter{SourceTypes: []string{}, Instances: []string{}}))
+			Expect(options.Formatter).To(Equal(logs.LogFormatter{
+				Format:          logs.FormatText,
+				TimestampLayout: logs.DefaultTimestampLayout,
+				Color:           true,
+			}))
+			Expect(options.Writer).To(BeNil())
 		})
 
 		It("handles invalid appguids", func() {
 			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{})
 
 			Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage"))
-			Expect(fakeTailedLogsOutputter.OutputTailedLogsCallCount()).To(Equal(0))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+		})
+
+		It("filters by source type, instance, message type and expressions", func() {
+			args := []string{
+				"--source=APP",
+				"--source=HEALTH",
+				"--instance=3",
+				"--stderr",
+				"--include=GET",
+				"--exclude=favicon",
+				"--highlight=/index",
+				"my-app-guid",
+			}
+
+			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, args)
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			filter := options.Filter
+			Expect(filter.SourceTypes).To(Equal([]string{"APP", "HEALTH"}))
+			Expect(filter.Instances).To(Equal([]string{"3"}))
+			Expect(filter.MessageTypes).To(Equal([]events.LogMessage_MessageType{events.LogMessage_ERR}))
+			Expect(filter.Include.String()).To(Equal("GET"))
+			Expect(filter.Exclude.String()).To(Equal("favicon"))
+			Expect(filter.Highlight.String()).To(Equal("/index"))
+		})
+
+		It("shows both stdout and stderr when both flags are passed", func() {
+			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--stdout", "--stderr", "my-app-guid"})
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			filter := options.Filter
+			Expect(filter.MessageTypes).To(BeEmpty())
+		})
+
+		It("rejects instance indexes that are not integers", func() {
+			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--instance=three", "my-app-guid"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Instance must be a non-negative integer: three"))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("rejects invalid regular expressions", func() {
+			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--include=(", "my-app-guid"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Invalid --include expression"))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("formats logs with the requested format and timestamp layout", func() {
+			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--format=json", "--timestamp-format=rfc3339", "--utc", "my-app-guid"})
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(options.Formatter).To(Equal(logs.LogFormatter{
+				Format:          logs.FormatJSON,
+				TimestampLayout: time.RFC3339Nano,
+				UTC:             true,
+				Color:           true,
+			}))
+		})
+
+		It("accepts a go time layout as the timestamp format", func() {
+			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--timestamp-format=15:04:05.000", "my-app-guid"})
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(options.Formatter.TimestampLayout).To(Equal("15:04:05.000"))
+		})
+
+		It("rejects unknown formats", func() {
+			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--format=xml", "my-app-guid"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Unknown format: xml. Supported formats are: text, json, raw"))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("passes the reconnect timeout", func() {
+			test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--reconnect-timeout=30s", "my-app-guid"})
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(options.ReconnectTimeout).To(Equal(30 * time.Second))
+		})
+
+		It("rejects negative reconnect timeouts", func() {
+			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--reconnect-timeout=-1s", "my-app-guid"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("--reconnect-timeout must not be negative"))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(BeZero())
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+		})
+
+		It("exits when the log stream is lost", func() {
+			fakeTailedLogsOutputter.OutputTailedLogsWithOptionsReturns(console_tailed_logs_outputter.StreamLostError{AppGuid: "my-app-guid", Timeout: 30 * time.Second})
+
+			test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--reconnect-timeout=30s", "my-app-guid"})
+
+			Expect(outputBuffer).To(test_helpers.Say("Could not reconnect to the log stream for my-app-guid within 30s."))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.LogStreamLost}))
+		})
+
+		Context("when tailing several apps", func() {
+			BeforeEach(func() {
+				fakeAppExaminer.ListAppsReturns([]app_examiner.AppInfo{
+					{ProcessGuid: "web-b"},
+					{ProcessGuid: "worker", EnvironmentVariables: []app_examiner.EnvironmentVariable{{Name: "TEAM", Value: "payments"}}},
+					{ProcessGuid: "web-a"},
+				}, nil)
+			})
+
+			It("merges the logs of the named apps", func() {
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--source=APP", "web-a", "worker"})
+
+				Eventually(fakeTailedLogsOutputter.OutputMergedTailedLogsCallCount).Should(Equal(1))
+				appGuids, options := fakeTailedLogsOutputter.OutputMergedTailedLogsArgsForCall(0)
+				Expect(appGuids).To(Equal([]string{"web-a", "worker"}))
+				Expect(options.Filter.SourceTypes).To(Equal([]string{"APP"}))
+				Expect(fakeAppExaminer.ListAppsCallCount()).To(BeZero())
+			})
+
+			It("merges the logs of every app with --all", func() {
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--all"})
+
+				Eventually(fakeTailedLogsOutputter.OutputMergedTailedLogsCallCount).Should(Equal(1))
+				appGuids, _ := fakeTailedLogsOutputter.OutputMergedTailedLogsArgsForCall(0)
+				Expect(appGuids).To(Equal([]string{"web-a", "web-b", "worker"}))
+			})
+
+			It("merges the logs of apps whose names match the selector", func() {
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--selector=web-*"})
+
+				Eventually(fakeTailedLogsOutputter.OutputMergedTailedLogsCallCount).Should(Equal(1))
+				appGuids, _ := fakeTailedLogsOutputter.OutputMergedTailedLogsArgsForCall(0)
+				Expect(appGuids).To(Equal([]string{"web-a", "web-b"}))
+			})
+
+			It("merges the logs of apps with the selected environment variable", func() {
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--selector=TEAM=payments"})
+
+				Eventually(fakeTailedLogsOutputter.OutputMergedTailedLogsCallCount).Should(Equal(1))
+				appGuids, _ := fakeTailedLogsOutputter.OutputMergedTailedLogsArgsForCall(0)
+				Expect(appGuids).To(Equal([]string{"worker"}))
+			})
+
+			It("reports when no apps match", func() {
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--selector=api-*"})
+
+				Expect(outputBuffer).To(test_helpers.Say("No apps to tail."))
+				Expect(fakeTailedLogsOutputter.OutputMergedTailedLogsCallCount()).To(BeZero())
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.AppNotFound}))
+			})
+
+			It("reports errors listing the apps", func() {
+				fakeAppExaminer.ListAppsReturns(nil, errors.New("receptor down"))
+
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--all"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Error listing apps: receptor down"))
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
+			})
+
+			It("rejects app names combined with --all or --selector", func() {
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--all", "web-a"})
+
+				Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+				Expect(outputBuffer).To(test_helpers.Say("Pass either app names, --all or --selector"))
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+			})
+
+			It("rejects invalid selector patterns", func() {
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--selector=web-["})
+
+				Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+				Expect(outputBuffer).To(test_helpers.Say("Invalid --selector pattern: web-["))
+				Expect(fakeAppExaminer.ListAppsCallCount()).To(BeZero())
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+			})
+		})
+
+		Context("when the output is not a terminal", func() {
+			BeforeEach(func() {
+				commandFactory := command_factory.NewLogsCommandFactory(fakeAppExaminer, terminalUI, fakeTailedLogsOutputter, exitHandler, false)
+				logsCommand = commandFactory.MakeLogsCommand()
+			})
+
+			It("disables colors", func() {
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"my-app-guid"})
+
+				Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+				_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+				Expect(options.Formatter.Color).To(BeFalse())
+			})
+		})
+
+		Context("when writing to a file", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "logs-output")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("writes uncolored logs to the file", func() {
+				outputPath := filepath.Join(tmpDir, "app.log")
+				test_helpers.AsyncExecuteCommandWithArgs(logsCommand, []string{"--output=" + outputPath, "my-app-guid"})
+
+				Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+				_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+				Expect(options.Formatter.Color).To(BeFalse())
+				Expect(options.Writer).ToNot(BeNil())
+				Expect(outputBuffer).To(test_helpers.Say("Writing logs for my-app-guid to " + outputPath))
+
+				_, err := os.Stat(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("reports files that cannot be opened", func() {
+				outputPath := filepath.Join(tmpDir, "missing-dir", "app.log")
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--output=" + outputPath, "my-app-guid"})
+
+				Expect(outputBuffer).To(test_helpers.Say("Error opening " + outputPath))
+				Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.GeneralError}))
+			})
+
+			It("rejects negative rotation settings", func() {
+				test_helpers.ExecuteCommandWithArgs(logsCommand, []string{"--output=" + filepath.Join(tmpDir, "app.log"), "--output-backups=-1", "my-app-guid"})
+
+				Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+				Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(Equal(0))
+				Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
+			})
 		})
 	})
 
@@ -67,15 +333,55 @@ var _ = Describe("CommandFactory", func() {
 		var debugLogsCommand cli.Command
 
 		BeforeEach(func() {
-			commandFactory := command_factory.NewLogsCommandFactory(terminalUI, fakeTailedLogsOutputter, exitHandler)
+			commandFactory := command_factory.NewLogsCommandFactory(fakeAppExaminer, terminalUI, fakeTailedLogsOutputter, exitHandler, true)
 			debugLogsCommand = commandFactory.MakeDebugLogsCommand()
 		})
 
-		It("tails logs from the lattice-debug stream", func() {
+		It("tails logs from the lattice-debug stream, parsing lager entries", func() {
 			test_helpers.AsyncExecuteCommandWithArgs(debugLogsCommand, []string{})
 
-			Eventually(fakeTailedLogsOutputter.OutputTailedLogsCallCount).Should(Equal(1))
-			Expect(fakeTailedLogsOutputter.OutputTailedLogsArgsForCall(0)).To(Equal(reserved_app_ids.LatticeDebugLogStreamAppId))
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			appGuid, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(appGuid).To(Equal(reserved_app_ids.LatticeDebugLogStreamAppId))
+			Expect(options.Formatter.Lager).To(BeTrue())
+			Expect(options.Filter.MinLevel).To(Equal(lager.DEBUG))
+		})
+
+		It("filters by component, cell, level and session", func() {
+			args := []string{
+				"--component=rep",
+				"--component=executor",
+				"--cell=lattice-cell-01",
+				"--level=error",
+				"--session=7.2",
+			}
+
+			test_helpers.AsyncExecuteCommandWithArgs(debugLogsCommand, args)
+
+			Eventually(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount).Should(Equal(1))
+			_, options := fakeTailedLogsOutputter.OutputTailedLogsWithOptionsArgsForCall(0)
+			Expect(options.Filter.SourceTypes).To(Equal([]string{"rep", "executor"}))
+			Expect(options.Filter.Instances).To(Equal([]string{"lattice-cell-01"}))
+			Expect(options.Filter.MinLevel).To(Equal(lager.ERROR))
+			Expect(options.Filter.Session).To(Equal("7.2"))
+		})
+
+		It("exits when the debug log stream is lost", func() {
+			fakeTailedLogsOutputter.OutputTailedLogsWithOptionsReturns(console_tailed_logs_outputter.StreamLostError{AppGuid: reserved_app_ids.LatticeDebugLogStreamAppId})
+
+			test_helpers.ExecuteCommandWithArgs(debugLogsCommand, []string{})
+
+			Expect(outputBuffer).To(test_helpers.Say("Gave up reconnecting to the log stream for " + reserved_app_ids.LatticeDebugLogStreamAppId + "."))
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.LogStreamLost}))
+		})
+
+		It("rejects unknown levels", func() {
+			test_helpers.ExecuteCommandWithArgs(debugLogsCommand, []string{"--level=warn"})
+
+			Expect(outputBuffer).To(test_helpers.SayIncorrectUsage())
+			Expect(outputBuffer).To(test_helpers.Say("Unknown log level: warn. Supported levels are: debug, info, error, fatal"))
+			Expect(fakeTailedLogsOutputter.OutputTailedLogsWithOptionsCallCount()).To(BeZero())
+			Expect(exitHandler.ExitCalledWith).To(Equal([]int{exit_codes.InvalidSyntax}))
 		})
 	})
 
@@ -2,52 +2,282 @@ package console_tailed_logs_outputter
 
 import (
 	"fmt"
+	"io"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
 	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock"
 )
 
+// MergeWindow is how long logs from several apps are held back so that late
+// arrivals can still be printed in timestamp order.
+var MergeWindow = 250 * time.Millisecond
+
+var appPrefixColors = []func(string) string{colors.Green, colors.Cyan, colors.Yellow, colors.PurpleUnderline, colors.Red}
+
 type TailedLogsOutputter interface {
 	OutputTailedLogs(appGuid string)
+	OutputTailedLogsWithOptions(appGuid string, options OutputOptions) error
+	OutputMergedTailedLogs(appGuids []string, options OutputOptions) error
 	StopOutputting()
 }
 
+// OutputOptions select, render and direct tailed logs. Writer defaults to the
+// UI. When ReconnectTimeout is set, a stream that has been disconnected for
+// that long ends outputting with a StreamLostError, and streams whose reader
+// gives up before then are tailed again.
+type OutputOptions struct {
+	Filter           logs.LogFilter
+	Formatter        logs.LogFormatter
+	Writer           io.Writer
+	ReconnectTimeout time.Duration
+}
+
+type StreamLostError struct {
+	AppGuid string
+	Timeout time.Duration
+}
+
+func (e StreamLostError) Error() string {
+	if e.Timeout > 0 {
+		return fmt.Sprintf("Could not reconnect to the log stream for %s within %s.", e.AppGuid, e.Timeout)
+	}
+	return fmt.Sprintf("Gave up reconnecting to the log stream for %s.", e.AppGuid)
+}
+
 type ConsoleTailedLogsOutputter struct {
-	outputChan chan string
-	ui         terminal.UI
-	logReader  logs.LogReader
+	sync.Mutex
+	buffer           *logs.LineBuffer
+	stateChan        chan stateChange
+	ui               terminal.UI
+	logReaderFactory func() logs.LogReader
+	clock            clock.Clock
+	logReaders       map[tailedApp]logs.LogReader
+	cancelled        <-chan struct{}
+	stopChan         chan struct{}
+	stopped          bool
+}
+
+type byTimestamp []logs.BufferedLine
+
+func (l byTimestamp) Len() int           { return len(l) }
+func (l byTimestamp) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l byTimestamp) Less(i, j int) bool { return l[i].Timestamp < l[j].Timestamp }
+
+type tailedApp struct {
+	appGuid string
+	prefix  string
 }
 
-func NewConsoleTailedLogsOutputter(ui terminal.UI, logReader logs.LogReader) *ConsoleTailedLogsOutputter {
+type stateChange struct {
+	app   tailedApp
+	state logs.ConnectionState
+}
+
+// NewConsoleTailedLogsOutputter returns an outputter that tails each app with
+// its own log reader, and stops tailing when StopOutputting is called or
+// cancelled is closed.
+func NewConsoleTailedLogsOutputter(ui terminal.UI, logReaderFactory func() logs.LogReader, clock clock.Clock, cancelled <-chan struct{}) *ConsoleTailedLogsOutputter {
 	return &ConsoleTailedLogsOutputter{
-		outputChan: make(chan string, 10),
-		ui:         ui,
-		logReader:  logReader,
+		buffer:           logs.NewLineBuffer(clock),
+		stateChan:        make(chan stateChange),
+		ui:               ui,
+		logReaderFactory: logReaderFactory,
+		clock:            clock,
+		logReaders:       make(map[tailedApp]logs.LogReader),
+		cancelled:        cancelled,
+		stopChan:         make(chan struct{}),
 	}
-
 }
 
 func (ctlo *ConsoleTailedLogsOutputter) OutputTailedLogs(appGuid string) {
-	go ctlo.logReader.TailLogs(appGuid, ctlo.logCallback, ctlo.errorCallback)
+	ctlo.OutputTailedLogsWithOptions(appGuid, OutputOptions{
+		Formatter: logs.LogFormatter{Format: logs.FormatText, Color: true},
+	})
+}
 
-	for log := range ctlo.outputChan {
-		ctlo.ui.Say(log + "\n")
+func (ctlo *ConsoleTailedLogsOutputter) OutputTailedLogsWithOptions(appGuid string, options OutputOptions) error {
+	return ctlo.outputTailedApps([]tailedApp{{appGuid: appGuid}}, options, false)
+}
+
+// OutputMergedTailedLogs tails several apps at once, prefixing text logs with
+// the app name and printing them in timestamp order within MergeWindow.
+func (ctlo *ConsoleTailedLogsOutputter) OutputMergedTailedLogs(appGuids []string, options OutputOptions) error {
+	prefixWidth := 0
+	for _, appGuid := range appGuids {
+		if len(appGuid) > prefixWidth {
+			prefixWidth = len(appGuid)
+		}
 	}
+
+	apps := make([]tailedApp, len(appGuids))
+	for index, appGuid := range appGuids {
+		apps[index] = tailedApp{appGuid: appGuid, prefix: appPrefix(appGuid, prefixWidth, index, options.Formatter)}
+	}
+
+	return ctlo.outputTailedApps(apps, options, true)
 }
 
 func (ctlo *ConsoleTailedLogsOutputter) StopOutputting() {
-	ctlo.logReader.StopTailing()
+	ctlo.Lock()
+	defer ctlo.Unlock()
+
+	if ctlo.stopped {
+		return
+	}
+	ctlo.stopped = true
+
+	close(ctlo.stopChan)
+	for _, logReader := range ctlo.logReaders {
+		logReader.StopTailing()
+	}
 }
 
-func (ctlo *ConsoleTailedLogsOutputter) logCallback(log *events.LogMessage) {
-	timeString := time.Unix(0, log.GetTimestamp()).Format("02 Jan 15:04")
-	logOutput := fmt.Sprintf("%s [%s|%s] %s", colors.Cyan(timeString), colors.Yellow(log.GetSourceType()), colors.Yellow(log.GetSourceInstance()), log.GetMessage())
-	ctlo.outputChan <- logOutput
+func (ctlo *ConsoleTailedLogsOutputter) outputTailedApps(apps []tailedApp, options OutputOptions, merge bool) error {
+	for _, app := range apps {
+		if !ctlo.tail(app, options) {
+			return nil
+		}
+	}
+
+	writer := outputWriter(options, ctlo.ui)
+	ticker := ctlo.clock.NewTicker(MergeWindow / 2)
+	defer ticker.Stop()
+
+	var pending []logs.BufferedLine
+	flush := func(receivedBy time.Time) {
+		sort.Stable(byTimestamp(pending))
+		var held []logs.BufferedLine
+		for _, log := range pending {
+			if !log.ReceivedAt.After(receivedBy) {
+				fmt.Fprintln(writer, log.Line)
+			} else {
+				held = append(held, log)
+			}
+		}
+		pending = held
+		ctlo.buffer.ReportDropped(ctlo.ui, "log messages")
+	}
+	stop := func() {
+		ctlo.StopOutputting()
+		flush(ctlo.clock.Now())
+	}
+
+	disconnectedSince := make(map[string]time.Time)
+	for {
+		select {
+		case log := <-ctlo.buffer.Lines():
+			if merge {
+				pending = append(pending, log)
+			} else {
+				fmt.Fprintln(writer, log.Line)
+			}
+		case <-ticker.C():
+			if merge {
+				pending = append(pending, ctlo.buffer.Drain()...)
+			}
+			flush(ctlo.clock.Now().Add(-MergeWindow))
+			if options.ReconnectTimeout == 0 {
+				continue
+			}
+			for _, app := range apps {
+				since, disconnected := disconnectedSince[app.appGuid]
+				if disconnected && ctlo.clock.Now().Sub(since) >= options.ReconnectTimeout {
+					stop()
+					return StreamLostError{AppGuid: app.appGuid, Timeout: options.ReconnectTimeout}
+				}
+			}
+		case change := <-ctlo.stateChan:
+			appGuid := change.app.appGuid
+			_, disconnected := disconnectedSince[appGuid]
+			switch change.state {
+			case logs.Connected:
+				if disconnected {
+					delete(disconnectedSince, appGuid)
+					ctlo.ui.SayError(fmt.Sprintf("Reconnected to the log stream for %s.", appGuid))
+				}
+			case logs.Reconnecting:
+				if !disconnected {
+					disconnectedSince[appGuid] = ctlo.clock.Now()
+					ctlo.ui.SayError(fmt.Sprintf("Lost connection to the log stream for %s, reconnecting...", appGuid))
+				}
+			case logs.GaveUp:
+				if options.ReconnectTimeout == 0 {
+					stop()
+					return StreamLostError{AppGuid: appGuid}
+				}
+				if !disconnected {
+					disconnectedSince[appGuid] = ctlo.clock.Now()
+				}
+				ctlo.tail(change.app, options)
+			}
+		case <-ctlo.cancelled:
+			stop()
+			return nil
+		case <-ctlo.stopChan:
+			flush(ctlo.clock.Now())
+			return nil
+		}
+	}
 }
 
-func (ctlo *ConsoleTailedLogsOutputter) errorCallback(err error) {
-	ctlo.outputChan <- err.Error()
+// tail starts a log reader for the app, stopping the reader it supersedes when
+// the app is tailed again. It returns false once outputting has stopped.
+func (ctlo *ConsoleTailedLogsOutputter) tail(app tailedApp, options OutputOptions) bool {
+	ctlo.Lock()
+	defer ctlo.Unlock()
+
+	if ctlo.stopped {
+		return false
+	}
+
+	logCallback := func(log *events.LogMessage) {
+		if options.Filter.Matches(log) {
+			ctlo.buffer.Add(log.GetTimestamp(), app.prefix+options.Formatter.FormatLog(log, options.Filter))
+		}
+	}
+	errorCallback := func(err error) {
+		ctlo.ui.SayError(app.prefix + err.Error())
+	}
+	stateCallback := func(state logs.ConnectionState) {
+		select {
+		case ctlo.stateChan <- stateChange{app: app, state: state}:
+		case <-ctlo.stopChan:
+		}
+	}
+
+	if supersededLogReader, ok := ctlo.logReaders[app]; ok {
+		supersededLogReader.StopTailing()
+	}
+
+	logReader := ctlo.logReaderFactory()
+	ctlo.logReaders[app] = logReader
+	logReader.OnStateChange(stateCallback)
+	go logReader.TailLogs(app.appGuid, logCallback, errorCallback)
+
+	return true
+}
+
+func outputWriter(options OutputOptions, ui terminal.UI) io.Writer {
+	if options.Writer == nil {
+		return ui
+	}
+	return options.Writer
+}
+
+func appPrefix(appGuid string, width, index int, formatter logs.LogFormatter) string {
+	if formatter.Format != logs.FormatText {
+		return ""
+	}
+
+	prefix := fmt.Sprintf("%-*s", width, appGuid)
+	if formatter.Color {
+		prefix = appPrefixColors[index%len(appPrefixColors)](prefix)
+	}
+	return prefix + " | "
 }
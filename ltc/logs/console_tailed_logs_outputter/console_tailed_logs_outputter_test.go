@@ -3,35 +3,61 @@ package console_tailed_logs_outputter_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"regexp"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/console_tailed_logs_outputter"
 	"github.com/cloudfoundry-incubator/lattice/ltc/logs/fake_log_reader"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
 	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
 	"github.com/cloudfoundry-incubator/lattice/ltc/test_helpers"
 	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/clock/fakeclock"
 )
 
+type blockingWriter struct {
+	io.Writer
+	unblock chan struct{}
+}
+
+func (w blockingWriter) Write(p []byte) (int, error) {
+	<-w.unblock
+	return w.Writer.Write(p)
+}
+
 var _ = Describe("ConsoleTailedLogsOutputter", func() {
 	var (
 		outputBuffer *gbytes.Buffer
 		terminalUI   terminal.UI
+		fakeClock    *fakeclock.FakeClock
+		cancelled    chan struct{}
 	)
 
+	logReaderFactory := func(logReaders ...*fake_log_reader.FakeLogReader) func() logs.LogReader {
+		return func() logs.LogReader {
+			logReader := logReaders[0]
+			logReaders = logReaders[1:]
+			return logReader
+		}
+	}
+
 	BeforeEach(func() {
 		outputBuffer = gbytes.NewBuffer()
-		terminalUI = terminal.NewUI(nil, outputBuffer, nil)
+		terminalUI = terminal.NewUI(nil, outputBuffer, outputBuffer, nil)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		cancelled = make(chan struct{})
 	})
 
 	Describe("OutputTailedLogs", func() {
 		It("Tails logs", func() {
 			logReader := fake_log_reader.NewFakeLogReader()
-			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReader)
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
 
 			time := time.Now()
 			sourceType := "RTR"
@@ -51,21 +77,342 @@ var _ = Describe("ConsoleTailedLogsOutputter", func() {
 			Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
 
 			logOutputBufferString := fmt.Sprintf("%s [%s|%s] First log\n", colors.Cyan(time.Format("02 Jan 15:04")), colors.Yellow(sourceType), colors.Yellow(sourceInstance))
-			Eventually(outputBuffer).Should(test_helpers.Say(logOutputBufferString))
+			output := func() string { return string(outputBuffer.Contents()) }
+			Eventually(output).Should(ContainSubstring(logOutputBufferString))
+			Eventually(output).Should(ContainSubstring("First Error\n"))
+		})
+	})
+
+	Describe("OutputTailedLogsWithOptions", func() {
+		It("only outputs logs matching the filter, highlighting matches", func() {
+			logReader := fake_log_reader.NewFakeLogReader()
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+			unixTime := time.Now().UnixNano()
+			appSourceType, healthSourceType := "APP", "HEALTH"
+			sourceInstance := "0"
+			logReader.AddLog(&events.LogMessage{
+				Message:        []byte("healthcheck GET passed"),
+				Timestamp:      &unixTime,
+				SourceType:     &healthSourceType,
+				SourceInstance: &sourceInstance,
+			})
+			logReader.AddLog(&events.LogMessage{
+				Message:        []byte("GET /index.html"),
+				Timestamp:      &unixTime,
+				SourceType:     &appSourceType,
+				SourceInstance: &sourceInstance,
+			})
+
+			filter := logs.LogFilter{
+				SourceTypes: []string{"APP"},
+				Include:     regexp.MustCompile("GET"),
+			}
+			go consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{
+				Filter:    filter,
+				Formatter: logs.LogFormatter{Format: logs.FormatText, Color: true},
+			})
+
+			Eventually(outputBuffer).Should(test_helpers.Say(colors.PurpleUnderline("GET") + " /index.html\n"))
+			Expect(outputBuffer.Contents()).ToNot(ContainSubstring("healthcheck"))
+		})
+
+		It("writes formatted logs to the given writer", func() {
+			logReader := fake_log_reader.NewFakeLogReader()
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+			unixTime := time.Now().UnixNano()
+			sourceType, sourceInstance := "APP", "0"
+			logReader.AddLog(&events.LogMessage{
+				Message:        []byte("First log"),
+				Timestamp:      &unixTime,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			})
+			logReader.AddError(errors.New("First Error"))
+
+			fileBuffer := gbytes.NewBuffer()
+			go consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{
+				Formatter: logs.LogFormatter{Format: logs.FormatRaw},
+				Writer:    fileBuffer,
+			})
 
+			Eventually(fileBuffer).Should(test_helpers.Say("First log\n"))
 			Eventually(outputBuffer).Should(test_helpers.Say("First Error\n"))
+			Expect(fileBuffer.Contents()).ToNot(ContainSubstring("First Error"))
+			Expect(outputBuffer.Contents()).ToNot(ContainSubstring("First log"))
+		})
+	})
+
+	Describe("backpressure", func() {
+		var originalOutputBufferSize int
+
+		BeforeEach(func() {
+			originalOutputBufferSize = logs.OutputBufferSize
+			logs.OutputBufferSize = 1
+		})
+
+		AfterEach(func() {
+			logs.OutputBufferSize = originalOutputBufferSize
+		})
+
+		It("drops logs the writer cannot keep up with and reports how many", func() {
+			logReader := fake_log_reader.NewFakeLogReader()
+			for i := 0; i < 10; i++ {
+				logReader.AddLog(&events.LogMessage{Message: []byte(fmt.Sprintf("log %d", i))})
+			}
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+			fileBuffer := gbytes.NewBuffer()
+			unblock := make(chan struct{})
+			go consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{
+				Formatter: logs.LogFormatter{Format: logs.FormatRaw},
+				Writer:    blockingWriter{Writer: fileBuffer, unblock: unblock},
+			})
+			Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+			Eventually(fakeClock.WatcherCount).Should(Equal(1))
+
+			close(unblock)
+			fakeClock.Increment(console_tailed_logs_outputter.MergeWindow)
+
+			Eventually(outputBuffer).Should(gbytes.Say(`Dropped \d+ log messages because the output could not keep up.`))
+			Expect(fileBuffer).To(test_helpers.Say("log 0\n"))
+		})
+	})
+
+	Describe("connection state", func() {
+		var (
+			logReader                  *fake_log_reader.FakeLogReader
+			consoleTailedLogsOutputter *console_tailed_logs_outputter.ConsoleTailedLogsOutputter
+		)
+
+		BeforeEach(func() {
+			logReader = fake_log_reader.NewFakeLogReader()
+		})
+
+		It("reports losing and regaining the connection", func() {
+			consoleTailedLogsOutputter = console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+			go consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{})
+			Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+
+			logReader.ChangeState(logs.Connected)
+			logReader.ChangeState(logs.Reconnecting)
+			logReader.ChangeState(logs.Reconnecting)
+			logReader.ChangeState(logs.Connected)
+
+			Eventually(outputBuffer).Should(test_helpers.Say("Lost connection to the log stream for my-app-guid, reconnecting...\n"))
+			Eventually(outputBuffer).Should(test_helpers.Say("Reconnected to the log stream for my-app-guid.\n"))
+			Consistently(outputBuffer).ShouldNot(test_helpers.Say("Lost connection"))
+
+			consoleTailedLogsOutputter.StopOutputting()
+		})
+
+		It("returns a StreamLostError when the log reader gives up", func() {
+			consoleTailedLogsOutputter = console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{})
+			}()
+			Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+
+			logReader.ChangeState(logs.GaveUp)
+
+			var err error
+			Eventually(errChan).Should(Receive(&err))
+			Expect(err).To(Equal(console_tailed_logs_outputter.StreamLostError{AppGuid: "my-app-guid"}))
+			Expect(err.Error()).To(Equal("Gave up reconnecting to the log stream for my-app-guid."))
+			Expect(logReader.IsLogTailStopped()).To(BeTrue())
+		})
+
+		Context("with a reconnect timeout", func() {
+			It("tails the app again when the log reader gives up before the timeout", func() {
+				retriedLogReader := fake_log_reader.NewFakeLogReader()
+				consoleTailedLogsOutputter = console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader, retriedLogReader), fakeClock, cancelled)
+				go consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{
+					ReconnectTimeout: time.Minute,
+				})
+				Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+
+				logReader.ChangeState(logs.Reconnecting)
+				logReader.ChangeState(logs.GaveUp)
+
+				Eventually(retriedLogReader.GetAppGuid).Should(Equal("my-app-guid"))
+				Eventually(logReader.IsLogTailStopped).Should(BeTrue())
+				retriedLogReader.ChangeState(logs.Connected)
+				Eventually(outputBuffer).Should(test_helpers.Say("Reconnected to the log stream for my-app-guid.\n"))
+				Expect(retriedLogReader.IsLogTailStopped()).To(BeFalse())
+
+				consoleTailedLogsOutputter.StopOutputting()
+			})
+
+			It("returns a StreamLostError once disconnected for longer than the timeout", func() {
+				consoleTailedLogsOutputter = console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+				errChan := make(chan error, 1)
+				go func() {
+					errChan <- consoleTailedLogsOutputter.OutputTailedLogsWithOptions("my-app-guid", console_tailed_logs_outputter.OutputOptions{
+						ReconnectTimeout: time.Minute,
+					})
+				}()
+				Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+
+				logReader.ChangeState(logs.Reconnecting)
+				Consistently(errChan).ShouldNot(Receive())
+
+				fakeClock.Increment(time.Minute)
+
+				var err error
+				Eventually(errChan).Should(Receive(&err))
+				Expect(err).To(Equal(console_tailed_logs_outputter.StreamLostError{AppGuid: "my-app-guid", Timeout: time.Minute}))
+				Expect(err.Error()).To(Equal("Could not reconnect to the log stream for my-app-guid within 1m0s."))
+				Expect(logReader.IsLogTailStopped()).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("OutputMergedTailedLogs", func() {
+		var webLogReader, workerLogReader *fake_log_reader.FakeLogReader
+
+		newLogMessage := func(message string, timestamp time.Time) *events.LogMessage {
+			unixTime := timestamp.UnixNano()
+			sourceType, sourceInstance := "APP", "0"
+			return &events.LogMessage{
+				Message:        []byte(message),
+				Timestamp:      &unixTime,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		BeforeEach(func() {
+			webLogReader = fake_log_reader.NewFakeLogReader()
+			workerLogReader = fake_log_reader.NewFakeLogReader()
+
+			now := time.Now()
+			webLogReader.AddLog(newLogMessage("web second", now.Add(time.Second)))
+			workerLogReader.AddLog(newLogMessage("worker first", now))
+			workerLogReader.AddLog(newLogMessage("worker third", now.Add(2*time.Second)))
+		})
+
+		// passMergeWindow moves the clock past the MergeWindow of the logs
+		// already received, so that the next tick prints them.
+		passMergeWindow := func() {
+			Eventually(fakeClock.WatcherCount).Should(Equal(1))
+			fakeClock.Increment(console_tailed_logs_outputter.MergeWindow)
+		}
+
+		It("tails every app, merging their logs in timestamp order with app prefixes", func() {
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(webLogReader, workerLogReader), fakeClock, cancelled)
+
+			go consoleTailedLogsOutputter.OutputMergedTailedLogs([]string{"web", "worker"}, console_tailed_logs_outputter.OutputOptions{
+				Formatter: logs.LogFormatter{Format: logs.FormatText, TimestampLayout: "-"},
+			})
+
+			Eventually(webLogReader.GetAppGuid).Should(Equal("web"))
+			Eventually(workerLogReader.GetAppGuid).Should(Equal("worker"))
+
+			Consistently(outputBuffer).ShouldNot(test_helpers.Say("worker"))
+			passMergeWindow()
+
+			Eventually(outputBuffer).Should(test_helpers.Say("worker | - [APP|0] worker first\n"))
+			Eventually(outputBuffer).Should(test_helpers.Say("web    | - [APP|0] web second\n"))
+			Eventually(outputBuffer).Should(test_helpers.Say("worker | - [APP|0] worker third\n"))
+		})
+
+		It("colors the app prefixes", func() {
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(webLogReader, workerLogReader), fakeClock, cancelled)
+
+			go consoleTailedLogsOutputter.OutputMergedTailedLogs([]string{"web", "worker"}, console_tailed_logs_outputter.OutputOptions{
+				Formatter: logs.LogFormatter{Format: logs.FormatText, Color: true},
+			})
+
+			Eventually(webLogReader.GetAppGuid).Should(Equal("web"))
+			Eventually(workerLogReader.GetAppGuid).Should(Equal("worker"))
+			passMergeWindow()
+
+			Eventually(outputBuffer).Should(test_helpers.Say(colors.Cyan("worker") + " | "))
+			Eventually(outputBuffer).Should(test_helpers.Say(colors.Green("web   ") + " | "))
+		})
+
+		It("does not prefix raw logs", func() {
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(webLogReader, workerLogReader), fakeClock, cancelled)
+
+			go consoleTailedLogsOutputter.OutputMergedTailedLogs([]string{"web", "worker"}, console_tailed_logs_outputter.OutputOptions{
+				Formatter: logs.LogFormatter{Format: logs.FormatRaw},
+			})
+
+			Eventually(webLogReader.GetAppGuid).Should(Equal("web"))
+			Eventually(workerLogReader.GetAppGuid).Should(Equal("worker"))
+			passMergeWindow()
+
+			Eventually(outputBuffer).Should(test_helpers.Say("worker first\nweb second\nworker third\n"))
+		})
+
+		It("stops every stream when cancelled", func() {
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(webLogReader, workerLogReader), fakeClock, cancelled)
+
+			outputDone := make(chan struct{})
+			go func() {
+				consoleTailedLogsOutputter.OutputMergedTailedLogs([]string{"web", "worker"}, console_tailed_logs_outputter.OutputOptions{})
+				close(outputDone)
+			}()
+			Eventually(workerLogReader.GetAppGuid).Should(Equal("worker"))
+
+			close(cancelled)
+
+			Eventually(outputDone).Should(BeClosed())
+			Eventually(webLogReader.IsLogTailStopped).Should(BeTrue())
+			Eventually(workerLogReader.IsLogTailStopped).Should(BeTrue())
 		})
 	})
 
 	Describe("StopOutputting", func() {
 		It("stops outputting logs", func() {
 			logReader := fake_log_reader.NewFakeLogReader()
-			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReader)
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
 
-			go consoleTailedLogsOutputter.OutputTailedLogs("my-app-guid")
+			outputDone := make(chan struct{})
+			go func() {
+				consoleTailedLogsOutputter.OutputTailedLogs("my-app-guid")
+				close(outputDone)
+			}()
+			Eventually(logReader.GetAppGuid).Should(Equal("my-app-guid"))
+
+			consoleTailedLogsOutputter.StopOutputting()
+
+			Eventually(logReader.IsLogTailStopped).Should(BeTrue())
+			Eventually(outputDone).Should(BeClosed())
+		})
+
+		It("does not start tailing once stopped", func() {
+			logReaderCreated := false
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, func() logs.LogReader {
+				logReaderCreated = true
+				return fake_log_reader.NewFakeLogReader()
+			}, fakeClock, cancelled)
 
 			consoleTailedLogsOutputter.StopOutputting()
+			consoleTailedLogsOutputter.OutputTailedLogs("my-app-guid")
+
+			Expect(logReaderCreated).To(BeFalse())
+		})
+
+		It("stops outputting logs when cancelled", func() {
+			logReader := fake_log_reader.NewFakeLogReader()
+			consoleTailedLogsOutputter := console_tailed_logs_outputter.NewConsoleTailedLogsOutputter(terminalUI, logReaderFactory(logReader), fakeClock, cancelled)
+
+			outputDone := make(chan struct{})
+			go func() {
+				consoleTailedLogsOutputter.OutputTailedLogs("my-app-guid")
+				close(outputDone)
+			}()
+
+			Consistently(outputDone).ShouldNot(BeClosed())
+
+			close(cancelled)
 
+			Eventually(outputDone).Should(BeClosed())
 			Expect(logReader.IsLogTailStopped()).To(BeTrue())
 		})
 	})
@@ -19,6 +19,24 @@ type FakeTailedLogsOutputter struct {
 	outputTailedLogsArgsForCall []struct {
 		appGuid string
 	}
+	OutputTailedLogsWithOptionsStub        func(appGuid string, options console_tailed_logs_outputter.OutputOptions) error
+	outputTailedLogsWithOptionsMutex       sync.RWMutex
+	outputTailedLogsWithOptionsArgsForCall []struct {
+		appGuid string
+		options console_tailed_logs_outputter.OutputOptions
+	}
+	outputTailedLogsWithOptionsReturns struct {
+		result1 error
+	}
+	OutputMergedTailedLogsStub        func(appGuids []string, options console_tailed_logs_outputter.OutputOptions) error
+	outputMergedTailedLogsMutex       sync.RWMutex
+	outputMergedTailedLogsArgsForCall []struct {
+		appGuids []string
+		options  console_tailed_logs_outputter.OutputOptions
+	}
+	outputMergedTailedLogsReturns struct {
+		result1 error
+	}
 	StopOutputtingStub        func()
 	stopOutputtingMutex       sync.RWMutex
 	stopOutputtingArgsForCall []struct{}
@@ -49,6 +67,78 @@ func (fake *FakeTailedLogsOutputter) OutputTailedLogsArgsForCall(i int) string {
 	return fake.outputTailedLogsArgsForCall[i].appGuid
 }
 
+func (fake *FakeTailedLogsOutputter) OutputTailedLogsWithOptions(appGuid string, options console_tailed_logs_outputter.OutputOptions) error {
+	fake.outputTailedLogsWithOptionsMutex.Lock()
+	fake.outputTailedLogsWithOptionsArgsForCall = append(fake.outputTailedLogsWithOptionsArgsForCall, struct {
+		appGuid string
+		options console_tailed_logs_outputter.OutputOptions
+	}{appGuid, options})
+	fake.outputTailedLogsWithOptionsMutex.Unlock()
+	if fake.OutputTailedLogsWithOptionsStub != nil {
+		return fake.OutputTailedLogsWithOptionsStub(appGuid, options)
+	}
+	if fake.outputTailedLogsWithOptionsReturns.result1 != nil {
+		return fake.outputTailedLogsWithOptionsReturns.result1
+	}
+	<-fake.stopChan
+	return nil
+}
+
+func (fake *FakeTailedLogsOutputter) OutputTailedLogsWithOptionsCallCount() int {
+	fake.outputTailedLogsWithOptionsMutex.RLock()
+	defer fake.outputTailedLogsWithOptionsMutex.RUnlock()
+	return len(fake.outputTailedLogsWithOptionsArgsForCall)
+}
+
+func (fake *FakeTailedLogsOutputter) OutputTailedLogsWithOptionsArgsForCall(i int) (string, console_tailed_logs_outputter.OutputOptions) {
+	fake.outputTailedLogsWithOptionsMutex.RLock()
+	defer fake.outputTailedLogsWithOptionsMutex.RUnlock()
+	return fake.outputTailedLogsWithOptionsArgsForCall[i].appGuid, fake.outputTailedLogsWithOptionsArgsForCall[i].options
+}
+
+func (fake *FakeTailedLogsOutputter) OutputTailedLogsWithOptionsReturns(result1 error) {
+	fake.OutputTailedLogsWithOptionsStub = nil
+	fake.outputTailedLogsWithOptionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTailedLogsOutputter) OutputMergedTailedLogs(appGuids []string, options console_tailed_logs_outputter.OutputOptions) error {
+	fake.outputMergedTailedLogsMutex.Lock()
+	fake.outputMergedTailedLogsArgsForCall = append(fake.outputMergedTailedLogsArgsForCall, struct {
+		appGuids []string
+		options  console_tailed_logs_outputter.OutputOptions
+	}{appGuids, options})
+	fake.outputMergedTailedLogsMutex.Unlock()
+	if fake.OutputMergedTailedLogsStub != nil {
+		return fake.OutputMergedTailedLogsStub(appGuids, options)
+	}
+	if fake.outputMergedTailedLogsReturns.result1 != nil {
+		return fake.outputMergedTailedLogsReturns.result1
+	}
+	<-fake.stopChan
+	return nil
+}
+
+func (fake *FakeTailedLogsOutputter) OutputMergedTailedLogsCallCount() int {
+	fake.outputMergedTailedLogsMutex.RLock()
+	defer fake.outputMergedTailedLogsMutex.RUnlock()
+	return len(fake.outputMergedTailedLogsArgsForCall)
+}
+
+func (fake *FakeTailedLogsOutputter) OutputMergedTailedLogsArgsForCall(i int) ([]string, console_tailed_logs_outputter.OutputOptions) {
+	fake.outputMergedTailedLogsMutex.RLock()
+	defer fake.outputMergedTailedLogsMutex.RUnlock()
+	return fake.outputMergedTailedLogsArgsForCall[i].appGuids, fake.outputMergedTailedLogsArgsForCall[i].options
+}
+
+func (fake *FakeTailedLogsOutputter) OutputMergedTailedLogsReturns(result1 error) {
+	fake.OutputMergedTailedLogsStub = nil
+	fake.outputMergedTailedLogsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTailedLogsOutputter) StopOutputting() {
 	fake.stopOutputtingMutex.Lock()
 	fake.stopOutputtingArgsForCall = append(fake.stopOutputtingArgsForCall, struct{}{})
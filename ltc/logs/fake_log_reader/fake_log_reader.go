@@ -3,6 +3,7 @@ package fake_log_reader
 import (
 	"sync"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
 	"github.com/cloudfoundry/noaa/events"
 )
 
@@ -13,6 +14,7 @@ type FakeLogReader struct {
 	errors         []error
 	logTailStopped bool
 	appGuid        string
+	stateCallback  func(logs.ConnectionState)
 }
 
 func NewFakeLogReader() *FakeLogReader {
@@ -50,6 +52,24 @@ func (f *FakeLogReader) StopTailing() {
 	close(f.stopChan)
 }
 
+func (f *FakeLogReader) OnStateChange(stateCallback func(logs.ConnectionState)) {
+	f.Lock()
+	defer f.Unlock()
+	f.stateCallback = stateCallback
+}
+
+// ChangeState reports a connection state change to the callback registered
+// with OnStateChange.
+func (f *FakeLogReader) ChangeState(state logs.ConnectionState) {
+	f.RLock()
+	stateCallback := f.stateCallback
+	f.RUnlock()
+
+	if stateCallback != nil {
+		stateCallback(state)
+	}
+}
+
 func (f *FakeLogReader) GetAppGuid() string {
 	f.RLock()
 	defer f.RUnlock()
@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/chug"
+)
+
+var LogLevels = []string{"debug", "info", "error", "fatal"}
+
+// ParseLagerLog parses a message written by a lager logger, such as those
+// on the lattice-debug stream.
+func ParseLagerLog(message []byte) (chug.LogEntry, bool) {
+	entries := make(chan chug.Entry, bytes.Count(message, []byte("\n"))+1)
+	chug.Chug(bytes.NewReader(message), entries)
+
+	entry, ok := <-entries
+	if !ok || !entry.IsLager {
+		return chug.LogEntry{}, false
+	}
+	return entry.Log, true
+}
+
+func ParseLogLevel(level string) (lager.LogLevel, error) {
+	for index, name := range LogLevels {
+		if strings.EqualFold(name, level) {
+			return lager.LogLevel(index), nil
+		}
+	}
+	return lager.DEBUG, fmt.Errorf("Unknown log level: %s. Supported levels are: %s", level, strings.Join(LogLevels, ", "))
+}
+
+func LogLevelName(level lager.LogLevel) string {
+	if level < 0 || int(level) >= len(LogLevels) {
+		return fmt.Sprintf("level-%d", level)
+	}
+	return LogLevels[level]
+}
+
+// sessionMatches reports whether session is the given lager session or one
+// of its nested sessions.
+func sessionMatches(session, filterSession string) bool {
+	return session == filterSession || strings.HasPrefix(session, filterSession+".")
+}
+
+func formatLagerData(data lager.Data) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, ok := data[key].(string)
+		if !ok {
+			jsonValue, _ := json.Marshal(data[key])
+			value = string(jsonValue)
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+	return strings.Join(pairs, " ")
+}
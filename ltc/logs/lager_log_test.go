@@ -0,0 +1,47 @@
+package logs_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/pivotal-golang/lager"
+)
+
+var _ = Describe("Lager logs", func() {
+	Describe("ParseLagerLog", func() {
+		It("parses lager entries", func() {
+			entry, ok := logs.ParseLagerLog([]byte(`{"timestamp":"1428021045.5","source":"rep","message":"rep.started","log_level":1,"data":{"session":"3","cell":"lattice-cell-01"}}`))
+
+			Expect(ok).To(BeTrue())
+			Expect(entry.Timestamp.Equal(time.Unix(1428021045, 5e8))).To(BeTrue())
+			Expect(entry.LogLevel).To(Equal(lager.INFO))
+			Expect(entry.Source).To(Equal("rep"))
+			Expect(entry.Message).To(Equal("rep.started"))
+			Expect(entry.Session).To(Equal("3"))
+			Expect(entry.Data).To(Equal(lager.Data{"cell": "lattice-cell-01"}))
+		})
+
+		It("does not parse other lines", func() {
+			_, ok := logs.ParseLagerLog([]byte("garden started"))
+			Expect(ok).To(BeFalse())
+
+			_, ok = logs.ParseLagerLog([]byte(""))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("ParseLogLevel", func() {
+		It("parses level names in any case", func() {
+			Expect(logs.ParseLogLevel("Error")).To(Equal(lager.ERROR))
+			Expect(logs.ParseLogLevel("debug")).To(Equal(lager.DEBUG))
+		})
+
+		It("rejects unknown levels", func() {
+			_, err := logs.ParseLogLevel("warn")
+			Expect(err).To(MatchError("Unknown log level: warn. Supported levels are: debug, info, error, fatal"))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package logs
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/pivotal-golang/clock"
+)
+
+// OutputBufferSize is how many lines a LineBuffer holds for a slow writer
+// before further lines are dropped.
+var OutputBufferSize = 1000
+
+// LineBuffer holds up to OutputBufferSize lines for a slow writer so that the
+// stream reader adding them is never blocked. Once it is full, further lines
+// are dropped and counted.
+type LineBuffer struct {
+	dropped uint64
+	lines   chan BufferedLine
+	clock   clock.Clock
+}
+
+type BufferedLine struct {
+	Timestamp  int64
+	ReceivedAt time.Time
+	Line       string
+}
+
+func NewLineBuffer(clock clock.Clock) *LineBuffer {
+	return &LineBuffer{lines: make(chan BufferedLine, OutputBufferSize), clock: clock}
+}
+
+func (b *LineBuffer) Add(timestamp int64, line string) {
+	select {
+	case b.lines <- BufferedLine{Timestamp: timestamp, ReceivedAt: b.clock.Now(), Line: line}:
+	default:
+		atomic.AddUint64(&b.dropped, 1)
+	}
+}
+
+func (b *LineBuffer) Lines() <-chan BufferedLine {
+	return b.lines
+}
+
+// Drain returns the lines waiting in the buffer without blocking.
+func (b *LineBuffer) Drain() []BufferedLine {
+	var lines []BufferedLine
+	for {
+		select {
+		case line := <-b.lines:
+			lines = append(lines, line)
+		default:
+			return lines
+		}
+	}
+}
+
+// ReportDropped tells the user how many lines, e.g. "log messages", have been
+// dropped since it was last called.
+func (b *LineBuffer) ReportDropped(ui terminal.UI, what string) {
+	if dropped := atomic.SwapUint64(&b.dropped, 0); dropped > 0 {
+		ui.SayError(fmt.Sprintf("Dropped %d %s because the output could not keep up.", dropped, what))
+	}
+}
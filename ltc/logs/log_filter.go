@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/lager"
+)
+
+// LogFilter selects which log messages are shown. Empty fields match
+// everything. Source types also match on their base name, so that the
+// component binaries on the lattice-debug stream can be named without a path.
+//
+// MinLevel and Session only match lager entries; other messages are hidden
+// when either is set.
+type LogFilter struct {
+	SourceTypes  []string
+	Instances    []string
+	MessageTypes []events.LogMessage_MessageType
+	Include      *regexp.Regexp
+	Exclude      *regexp.Regexp
+	Highlight    *regexp.Regexp
+	MinLevel     lager.LogLevel
+	Session      string
+}
+
+func (filter LogFilter) Matches(log *events.LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !containsFold(filter.SourceTypes, log.GetSourceType()) && !containsFold(filter.SourceTypes, path.Base(log.GetSourceType())) {
+		return false
+	}
+
+	if len(filter.Instances) > 0 && !containsFold(filter.Instances, log.GetSourceInstance()) {
+		return false
+	}
+
+	if len(filter.MessageTypes) > 0 && !containsMessageType(filter.MessageTypes, log.GetMessageType()) {
+		return false
+	}
+
+	message := log.GetMessage()
+	if filter.Include != nil && !filter.Include.Match(message) {
+		return false
+	}
+
+	if filter.Exclude != nil && filter.Exclude.Match(message) {
+		return false
+	}
+
+	if filter.MinLevel > lager.DEBUG || filter.Session != "" {
+		entry, ok := ParseLagerLog(message)
+		if !ok || entry.LogLevel < filter.MinLevel {
+			return false
+		}
+
+		if filter.Session != "" && !sessionMatches(entry.Session, filter.Session) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HighlightMatches applies highlight to every part of message matched by the
+// Highlight expression, or by the Include expression when none is given.
+func (filter LogFilter) HighlightMatches(message string, highlight func(string) string) string {
+	highlightRegexp := filter.Highlight
+	if highlightRegexp == nil {
+		highlightRegexp = filter.Include
+	}
+
+	if highlightRegexp == nil {
+		return message
+	}
+
+	return highlightRegexp.ReplaceAllStringFunc(message, highlight)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMessageType(messageTypes []events.LogMessage_MessageType, messageType events.LogMessage_MessageType) bool {
+	for _, candidate := range messageTypes {
+		if candidate == messageType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package logs_test
+
+import (
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/lager"
+)
+
+var _ = Describe("LogFilter", func() {
+	newLogMessage := func(message, sourceType, sourceInstance string, messageType events.LogMessage_MessageType) *events.LogMessage {
+		return &events.LogMessage{
+			Message:        []byte(message),
+			SourceType:     &sourceType,
+			SourceInstance: &sourceInstance,
+			MessageType:    &messageType,
+		}
+	}
+
+	var (
+		appLog    *events.LogMessage
+		healthLog *events.LogMessage
+		errLog    *events.LogMessage
+	)
+
+	BeforeEach(func() {
+		appLog = newLogMessage("GET /index.html 200", "APP", "3", events.LogMessage_OUT)
+		healthLog = newLogMessage("healthcheck passed", "HEALTH", "0", events.LogMessage_OUT)
+		errLog = newLogMessage("panic: GET /boom", "APP", "0", events.LogMessage_ERR)
+	})
+
+	Describe("Matches", func() {
+		It("matches everything when empty", func() {
+			filter := logs.LogFilter{}
+
+			Expect(filter.Matches(appLog)).To(BeTrue())
+			Expect(filter.Matches(healthLog)).To(BeTrue())
+			Expect(filter.Matches(errLog)).To(BeTrue())
+		})
+
+		It("filters by source type, ignoring case", func() {
+			filter := logs.LogFilter{SourceTypes: []string{"app"}}
+
+			Expect(filter.Matches(appLog)).To(BeTrue())
+			Expect(filter.Matches(healthLog)).To(BeFalse())
+		})
+
+		It("filters by instance index", func() {
+			filter := logs.LogFilter{Instances: []string{"0"}}
+
+			Expect(filter.Matches(appLog)).To(BeFalse())
+			Expect(filter.Matches(healthLog)).To(BeTrue())
+			Expect(filter.Matches(errLog)).To(BeTrue())
+		})
+
+		It("filters by stdout or stderr", func() {
+			filter := logs.LogFilter{MessageTypes: []events.LogMessage_MessageType{events.LogMessage_ERR}}
+
+			Expect(filter.Matches(appLog)).To(BeFalse())
+			Expect(filter.Matches(errLog)).To(BeTrue())
+		})
+
+		It("filters by included and excluded expressions", func() {
+			filter := logs.LogFilter{
+				Include: regexp.MustCompile("GET"),
+				Exclude: regexp.MustCompile("boom"),
+			}
+
+			Expect(filter.Matches(appLog)).To(BeTrue())
+			Expect(filter.Matches(healthLog)).To(BeFalse())
+			Expect(filter.Matches(errLog)).To(BeFalse())
+		})
+
+		It("requires every criterion to match", func() {
+			filter := logs.LogFilter{
+				SourceTypes: []string{"APP"},
+				Instances:   []string{"0"},
+				Include:     regexp.MustCompile("GET"),
+			}
+
+			Expect(filter.Matches(appLog)).To(BeFalse())
+			Expect(filter.Matches(healthLog)).To(BeFalse())
+			Expect(filter.Matches(errLog)).To(BeTrue())
+		})
+	})
+
+	Describe("Matches on the lattice-debug stream", func() {
+		var repError, repInfo, executorDebug, plainLine *events.LogMessage
+
+		BeforeEach(func() {
+			repError = newLogMessage(`{"timestamp":"1428000000.1","source":"rep","message":"rep.auction.failed","log_level":2,"data":{"session":"7.2"}}`, "/var/lattice/jobs/rep", "lattice-cell-01", events.LogMessage_OUT)
+			repInfo = newLogMessage(`{"timestamp":"1428000000.2","source":"rep","message":"rep.started","log_level":1,"data":{"session":"71"}}`, "/var/lattice/jobs/rep", "lattice-cell-02", events.LogMessage_OUT)
+			executorDebug = newLogMessage(`{"timestamp":"1428000000.3","source":"executor","message":"executor.tick","log_level":0,"data":{"session":"7"}}`, "executor", "lattice-cell-01", events.LogMessage_OUT)
+			plainLine = newLogMessage("garden started", "garden-linux", "lattice-cell-01", events.LogMessage_OUT)
+		})
+
+		It("matches components by the base name of the source type", func() {
+			filter := logs.LogFilter{SourceTypes: []string{"rep"}}
+
+			Expect(filter.Matches(repError)).To(BeTrue())
+			Expect(filter.Matches(executorDebug)).To(BeFalse())
+		})
+
+		It("filters lager entries by minimum level, hiding other lines", func() {
+			filter := logs.LogFilter{MinLevel: lager.INFO}
+
+			Expect(filter.Matches(repError)).To(BeTrue())
+			Expect(filter.Matches(repInfo)).To(BeTrue())
+			Expect(filter.Matches(executorDebug)).To(BeFalse())
+			Expect(filter.Matches(plainLine)).To(BeFalse())
+		})
+
+		It("filters lager entries by session and its nested sessions", func() {
+			filter := logs.LogFilter{Session: "7"}
+
+			Expect(filter.Matches(repError)).To(BeTrue())
+			Expect(filter.Matches(executorDebug)).To(BeTrue())
+			Expect(filter.Matches(repInfo)).To(BeFalse())
+			Expect(filter.Matches(plainLine)).To(BeFalse())
+		})
+
+		It("shows every line without lager criteria", func() {
+			Expect(logs.LogFilter{}.Matches(plainLine)).To(BeTrue())
+		})
+	})
+
+	Describe("HighlightMatches", func() {
+		It("highlights matches of the include expression", func() {
+			filter := logs.LogFilter{Include: regexp.MustCompile("GET|200")}
+
+			Expect(filter.HighlightMatches("GET /index.html 200", strings.ToLower)).To(Equal("get /index.html 200"))
+		})
+
+		It("prefers the highlight expression", func() {
+			filter := logs.LogFilter{
+				Include:   regexp.MustCompile("GET"),
+				Highlight: regexp.MustCompile("index"),
+			}
+
+			Expect(filter.HighlightMatches("GET /index.html", strings.ToUpper)).To(Equal("GET /INDEX.html"))
+		})
+
+		It("leaves the message alone without an expression", func() {
+			Expect(logs.LogFilter{}.HighlightMatches("GET /index.html", strings.ToUpper)).To(Equal("GET /index.html"))
+		})
+	})
+})
@@ -0,0 +1,190 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry/noaa/events"
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/chug"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatRaw  = "raw"
+
+	DefaultTimestampLayout = "02 Jan 15:04"
+
+	TimestampFormatShort   = "short"
+	TimestampFormatRFC3339 = "rfc3339"
+)
+
+var Formats = []string{FormatText, FormatJSON, FormatRaw}
+
+// LogFormatter renders log messages as text, JSON or the raw message. The
+// zero value renders uncolored text with the default timestamp layout in
+// local time. With Lager set, messages written by lager loggers are parsed
+// and rendered field by field.
+type LogFormatter struct {
+	Format          string
+	TimestampLayout string
+	UTC             bool
+	Color           bool
+	Lager           bool
+}
+
+type jsonLogMessage struct {
+	Timestamp      string        `json:"timestamp"`
+	AppId          string        `json:"app_id"`
+	SourceType     string        `json:"source_type"`
+	SourceInstance string        `json:"source_instance"`
+	MessageType    string        `json:"message_type"`
+	Message        string        `json:"message"`
+	Lager          *jsonLagerLog `json:"lager,omitempty"`
+}
+
+type jsonLagerLog struct {
+	Timestamp string     `json:"timestamp"`
+	Level     string     `json:"level"`
+	Source    string     `json:"source"`
+	Message   string     `json:"message"`
+	Session   string     `json:"session,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	Data      lager.Data `json:"data,omitempty"`
+}
+
+func IsValidFormat(format string) bool {
+	for _, validFormat := range Formats {
+		if format == validFormat {
+			return true
+		}
+	}
+	return false
+}
+
+// TimestampLayout turns a --timestamp-format value, short, rfc3339 or a Go
+// time layout, into a time layout.
+func TimestampLayout(timestampFormat string) string {
+	switch timestampFormat {
+	case TimestampFormatShort, "":
+		return DefaultTimestampLayout
+	case TimestampFormatRFC3339:
+		return time.RFC3339Nano
+	}
+	return timestampFormat
+}
+
+func (formatter LogFormatter) FormatLog(log *events.LogMessage, filter LogFilter) string {
+	timestamp := formatter.localize(time.Unix(0, log.GetTimestamp()))
+
+	var lagerLog *chug.LogEntry
+	if formatter.Lager && formatter.Format != FormatRaw {
+		if entry, ok := ParseLagerLog(log.GetMessage()); ok {
+			lagerLog = &entry
+		}
+	}
+
+	switch formatter.Format {
+	case FormatJSON:
+		jsonLog := jsonLogMessage{
+			Timestamp:      timestamp.Format(time.RFC3339Nano),
+			AppId:          log.GetAppId(),
+			SourceType:     log.GetSourceType(),
+			SourceInstance: log.GetSourceInstance(),
+			MessageType:    log.GetMessageType().String(),
+			Message:        string(log.GetMessage()),
+		}
+		if lagerLog != nil {
+			jsonLog.Lager = formatter.jsonLagerLog(*lagerLog)
+		}
+		return formatter.toJSON(jsonLog)
+	case FormatRaw:
+		return string(log.GetMessage())
+	}
+
+	message := string(log.GetMessage())
+	if lagerLog != nil {
+		timestamp = formatter.localize(lagerLog.Timestamp)
+		message = formatter.lagerText(*lagerLog)
+	}
+	if formatter.Color {
+		message = filter.HighlightMatches(message, colors.PurpleUnderline)
+	}
+
+	return fmt.Sprintf("%s [%s|%s] %s",
+		formatter.colorize(timestamp.Format(formatter.timestampLayout()), colors.Cyan),
+		formatter.colorize(log.GetSourceType(), colors.Yellow),
+		formatter.colorize(log.GetSourceInstance(), colors.Yellow),
+		message,
+	)
+}
+
+func (formatter LogFormatter) localize(timestamp time.Time) time.Time {
+	if formatter.UTC {
+		return timestamp.UTC()
+	}
+	return timestamp.Local()
+}
+
+func (formatter LogFormatter) lagerText(entry chug.LogEntry) string {
+	level := strings.ToUpper(LogLevelName(entry.LogLevel))
+	switch entry.LogLevel {
+	case lager.ERROR, lager.FATAL:
+		level = formatter.colorize(level, colors.Red)
+	case lager.INFO:
+		level = formatter.colorize(level, colors.Green)
+	}
+
+	parts := []string{level, formatter.colorize(entry.Message, colors.Bold)}
+	if entry.Session != "" {
+		parts = append(parts, "session="+entry.Session)
+	}
+	if entry.Error != nil {
+		parts = append(parts, formatter.colorize("error="+entry.Error.Error(), colors.Red))
+	}
+	if len(entry.Data) > 0 {
+		parts = append(parts, formatLagerData(entry.Data))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (formatter LogFormatter) jsonLagerLog(entry chug.LogEntry) *jsonLagerLog {
+	jsonLog := &jsonLagerLog{
+		Timestamp: formatter.localize(entry.Timestamp).Format(time.RFC3339Nano),
+		Level:     LogLevelName(entry.LogLevel),
+		Source:    entry.Source,
+		Message:   entry.Message,
+		Session:   entry.Session,
+		Data:      entry.Data,
+	}
+	if entry.Error != nil {
+		jsonLog.Error = entry.Error.Error()
+	}
+	return jsonLog
+}
+
+func (formatter LogFormatter) timestampLayout() string {
+	if formatter.TimestampLayout == "" {
+		return DefaultTimestampLayout
+	}
+	return formatter.TimestampLayout
+}
+
+func (formatter LogFormatter) colorize(output string, color func(string) string) string {
+	if !formatter.Color {
+		return output
+	}
+	return color(output)
+}
+
+func (formatter LogFormatter) toJSON(v interface{}) string {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(jsonBytes)
+}
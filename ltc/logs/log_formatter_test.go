@@ -0,0 +1,148 @@
+package logs_test
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal/colors"
+	"github.com/cloudfoundry/noaa/events"
+)
+
+var _ = Describe("LogFormatter", func() {
+	var (
+		logTime    time.Time
+		logMessage *events.LogMessage
+	)
+
+	BeforeEach(func() {
+		logTime = time.Date(2015, time.April, 2, 17, 30, 45, 123456789, time.FixedZone("PDT", -7*60*60))
+		unixTime := logTime.UnixNano()
+		appId, sourceType, sourceInstance := "my-app", "APP", "3"
+		messageType := events.LogMessage_ERR
+
+		logMessage = &events.LogMessage{
+			Message:        []byte("GET /index.html"),
+			Timestamp:      &unixTime,
+			AppId:          &appId,
+			SourceType:     &sourceType,
+			SourceInstance: &sourceInstance,
+			MessageType:    &messageType,
+		}
+	})
+
+	Describe("FormatLog", func() {
+		Context("with the text format", func() {
+			It("uses the default timestamp layout in local time", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatText}
+
+				Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(Equal(logTime.Local().Format(logs.DefaultTimestampLayout) + " [APP|3] GET /index.html"))
+			})
+
+			It("uses the given timestamp layout in UTC", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatText, TimestampLayout: time.RFC3339Nano, UTC: true}
+
+				Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(Equal("2015-04-03T00:30:45.123456789Z [APP|3] GET /index.html"))
+			})
+
+			It("colors and highlights the log when color is enabled", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatText, Color: true, UTC: true}
+				filter := logs.LogFilter{Highlight: regexp.MustCompile("index")}
+
+				Expect(formatter.FormatLog(logMessage, filter)).To(Equal(
+					colors.Cyan("03 Apr 00:30") + " [" + colors.Yellow("APP") + "|" + colors.Yellow("3") + "] GET /" + colors.PurpleUnderline("index") + ".html",
+				))
+			})
+
+			It("does not highlight matches when color is disabled", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatText, UTC: true}
+				filter := logs.LogFilter{Highlight: regexp.MustCompile("index")}
+
+				Expect(formatter.FormatLog(logMessage, filter)).To(Equal("03 Apr 00:30 [APP|3] GET /index.html"))
+			})
+		})
+
+		Context("with the json format", func() {
+			It("renders the log as a json object with an RFC3339 timestamp", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatJSON, UTC: true}
+
+				Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(MatchJSON(`{
+					"timestamp": "2015-04-03T00:30:45.123456789Z",
+					"app_id": "my-app",
+					"source_type": "APP",
+					"source_instance": "3",
+					"message_type": "ERR",
+					"message": "GET /index.html"
+				}`))
+			})
+		})
+
+		Context("with the raw format", func() {
+			It("renders only the message", func() {
+				formatter := logs.LogFormatter{Format: logs.FormatRaw, Color: true}
+
+				Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(Equal("GET /index.html"))
+			})
+		})
+	})
+
+	Describe("FormatLog with Lager", func() {
+		BeforeEach(func() {
+			logMessage.Message = []byte(`{"timestamp":"1428021045.5","source":"rep","message":"rep.auction.failed","log_level":2,"data":{"session":"7.2","error":"no cells","process-guid":"my-app","instances":[1,2]}}`)
+		})
+
+		It("renders the level, message, session, error and data of lager entries", func() {
+			formatter := logs.LogFormatter{Format: logs.FormatText, UTC: true, TimestampLayout: "15:04:05.0", Lager: true}
+
+			Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(Equal(`00:30:45.5 [APP|3] ERROR rep.auction.failed session=7.2 error=no cells instances=[1,2] process-guid=my-app`))
+		})
+
+		It("colors the level and message when color is enabled", func() {
+			formatter := logs.LogFormatter{Format: logs.FormatText, UTC: true, Color: true, Lager: true}
+
+			Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(ContainSubstring(colors.Red("ERROR") + " " + colors.Bold("rep.auction.failed")))
+		})
+
+		It("adds the parsed entry to json output", func() {
+			formatter := logs.LogFormatter{Format: logs.FormatJSON, UTC: true, Lager: true}
+
+			Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(MatchJSON(`{
+				"timestamp": "2015-04-03T00:30:45.123456789Z",
+				"app_id": "my-app",
+				"source_type": "APP",
+				"source_instance": "3",
+				"message_type": "ERR",
+				"message": ` + strconv.Quote(string(logMessage.Message)) + `,
+				"lager": {
+					"timestamp": "2015-04-03T00:30:45.5Z",
+					"level": "error",
+					"source": "rep",
+					"message": "rep.auction.failed",
+					"session": "7.2",
+					"error": "no cells",
+					"data": {"process-guid": "my-app", "instances": [1, 2]}
+				}
+			}`))
+		})
+
+		It("renders other lines as usual", func() {
+			logMessage.Message = []byte("garden started")
+			formatter := logs.LogFormatter{Format: logs.FormatText, UTC: true, Lager: true}
+
+			Expect(formatter.FormatLog(logMessage, logs.LogFilter{})).To(Equal("03 Apr 00:30 [APP|3] garden started"))
+		})
+	})
+
+	Describe("IsValidFormat", func() {
+		It("accepts the supported formats", func() {
+			for _, format := range logs.Formats {
+				Expect(logs.IsValidFormat(format)).To(BeTrue())
+			}
+			Expect(logs.IsValidFormat("xml")).To(BeFalse())
+		})
+	})
+})
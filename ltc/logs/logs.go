@@ -1,44 +1,84 @@
 package logs
 
 import (
+	"sync"
+
 	"github.com/cloudfoundry/noaa/events"
 )
 
+type ConnectionState int
+
+const (
+	Connected ConnectionState = iota
+	Reconnecting
+	GaveUp
+)
+
+func (state ConnectionState) String() string {
+	switch state {
+	case Connected:
+		return "connected"
+	case Reconnecting:
+		return "reconnecting"
+	case GaveUp:
+		return "gave up"
+	}
+	return "unknown"
+}
+
 type LogReader interface {
 	TailLogs(appGuid string, logCallback func(*events.LogMessage), errorCallback func(error))
 	StopTailing()
+
+	// OnStateChange registers a callback for changes to the connection
+	// state. It must be called before TailLogs.
+	OnStateChange(stateCallback func(ConnectionState))
 }
 
 type logConsumer interface {
 	TailingLogs(appGuid string, authToken string, outputChan chan<- *events.LogMessage, errorChan chan<- error, stopChan chan struct{})
+	SetOnConnectCallback(cb func())
+	Close() error
 }
 
 type logReader struct {
-	consumer logConsumer
-	stopChan chan struct{}
+	consumer      logConsumer
+	stopChan      chan struct{}
+	stopOnce      sync.Once
+	stateCallback func(ConnectionState)
 }
 
 func NewLogReader(consumer logConsumer) LogReader {
 	return &logReader{
-		consumer: consumer,
-		stopChan: make(chan struct{}),
+		consumer:      consumer,
+		stopChan:      make(chan struct{}),
+		stateCallback: func(ConnectionState) {},
 	}
 }
 
+func (l *logReader) OnStateChange(stateCallback func(ConnectionState)) {
+	l.stateCallback = stateCallback
+}
+
+// TailLogs returns once StopTailing is called or the consumer gives up
+// reconnecting. Every failed connection attempt is passed to errorCallback.
 func (l *logReader) TailLogs(appGuid string, logCallback func(*events.LogMessage), errorCallback func(error)) {
 	outputChan := make(chan *events.LogMessage, 10)
 	errorChan := make(chan error, 10)
 
+	l.consumer.SetOnConnectCallback(func() {
+		l.stateCallback(Connected)
+	})
 	go l.consumer.TailingLogs(appGuid, "", outputChan, errorChan, l.stopChan)
 
 	l.readChannels(outputChan, errorChan, logCallback, errorCallback)
-
-	close(l.stopChan)
-	close(outputChan)
 }
 
 func (l *logReader) StopTailing() {
-	l.stopChan <- struct{}{}
+	l.stopOnce.Do(func() {
+		close(l.stopChan)
+		l.consumer.Close()
+	})
 }
 
 func (l *logReader) readChannels(outputChan <-chan *events.LogMessage, errorChan <-chan error, logCallback func(*events.LogMessage), errorCallback func(error)) {
@@ -46,8 +86,19 @@ func (l *logReader) readChannels(outputChan <-chan *events.LogMessage, errorChan
 		select {
 		case <-l.stopChan:
 			return
-		case err := <-errorChan:
-			errorCallback(err)
+		case err, ok := <-errorChan:
+			if !ok {
+				select {
+				case <-l.stopChan:
+				default:
+					l.stateCallback(GaveUp)
+				}
+				return
+			}
+			if err != nil {
+				l.stateCallback(Reconnecting)
+				errorCallback(err)
+			}
 		case logMessage := <-outputChan:
 			logCallback(logMessage)
 		}
@@ -15,12 +15,19 @@ func NewFakeConsumer() *fakeConsumer {
 	return &fakeConsumer{
 		inboundLogStream:   make(chan *events.LogMessage),
 		inboundErrorStream: make(chan error),
+		connects:           make(chan struct{}),
+		giveUp:             make(chan struct{}),
 	}
 }
 
 type fakeConsumer struct {
+	sync.Mutex
 	inboundLogStream   chan *events.LogMessage
 	inboundErrorStream chan error
+	connects           chan struct{}
+	giveUp             chan struct{}
+	onConnect          func()
+	closeCount         int
 }
 
 func (consumer *fakeConsumer) TailingLogs(appGuid string, authToken string, outputChan chan<- *events.LogMessage, errorChan chan<- error, stopChan chan struct{}) {
@@ -29,6 +36,14 @@ func (consumer *fakeConsumer) TailingLogs(appGuid string, authToken string, outp
 		case <-stopChan:
 			defer close(errorChan)
 			return
+		case <-consumer.giveUp:
+			close(errorChan)
+			return
+		case <-consumer.connects:
+			consumer.Lock()
+			onConnect := consumer.onConnect
+			consumer.Unlock()
+			onConnect()
 		case err := <-consumer.inboundErrorStream:
 			errorChan <- err
 		case logMessage := <-consumer.inboundLogStream:
@@ -37,6 +52,25 @@ func (consumer *fakeConsumer) TailingLogs(appGuid string, authToken string, outp
 	}
 }
 
+func (consumer *fakeConsumer) SetOnConnectCallback(onConnect func()) {
+	consumer.Lock()
+	defer consumer.Unlock()
+	consumer.onConnect = onConnect
+}
+
+func (consumer *fakeConsumer) Close() error {
+	consumer.Lock()
+	defer consumer.Unlock()
+	consumer.closeCount++
+	return nil
+}
+
+func (consumer *fakeConsumer) getCloseCount() int {
+	consumer.Lock()
+	defer consumer.Unlock()
+	return consumer.closeCount
+}
+
 func (consumer *fakeConsumer) sendToInboundLogStream(logMessage *events.LogMessage) {
 	consumer.inboundLogStream <- logMessage
 }
@@ -62,6 +96,23 @@ func (mr *messageReceiver) GetMessages() []*events.LogMessage {
 	return mr.receivedMessages
 }
 
+type stateReceiver struct {
+	sync.RWMutex
+	receivedStates []logs.ConnectionState
+}
+
+func (s *stateReceiver) AppendState(state logs.ConnectionState) {
+	defer s.Unlock()
+	s.Lock()
+	s.receivedStates = append(s.receivedStates, state)
+}
+
+func (s *stateReceiver) GetStates() []logs.ConnectionState {
+	defer s.RUnlock()
+	s.RLock()
+	return s.receivedStates
+}
+
 type errorReceiver struct {
 	sync.RWMutex
 	receivedErrors []error
@@ -166,7 +217,68 @@ var _ = Describe("logs", func() {
 
 			logReader.StopTailing()
 
-			Expect(doneChan).To(BeClosed())
+			Eventually(doneChan).Should(BeClosed())
+			Expect(consumer.getCloseCount()).To(Equal(1))
+		})
+
+		It("can be called more than once", func() {
+			go logReader.TailLogs("app-guid", func(*events.LogMessage) {}, func(error) {})
+
+			logReader.StopTailing()
+			logReader.StopTailing()
+
+			Expect(consumer.getCloseCount()).To(Equal(1))
+		})
+	})
+
+	Describe("OnStateChange", func() {
+		var (
+			consumer  *fakeConsumer
+			logReader logs.LogReader
+			receiver  *stateReceiver
+			doneChan  chan struct{}
+		)
+
+		BeforeEach(func() {
+			consumer = NewFakeConsumer()
+			logReader = logs.NewLogReader(consumer)
+			receiver = &stateReceiver{}
+			logReader.OnStateChange(receiver.AppendState)
+
+			done := make(chan struct{})
+			doneChan = done
+			go func() {
+				logReader.TailLogs("app-guid", func(*events.LogMessage) {}, func(error) {})
+				close(done)
+			}()
+		})
+
+		It("reports reconnecting on errors and connected once the consumer connects", func() {
+			consumer.connects <- struct{}{}
+			Eventually(receiver.GetStates).Should(Equal([]logs.ConnectionState{logs.Connected}))
+
+			consumer.sendToInboundErrorStream(errors.New("connection reset"))
+			Eventually(receiver.GetStates).Should(Equal([]logs.ConnectionState{logs.Connected, logs.Reconnecting}))
+
+			consumer.connects <- struct{}{}
+			Eventually(receiver.GetStates).Should(Equal([]logs.ConnectionState{logs.Connected, logs.Reconnecting, logs.Connected}))
+
+			logReader.StopTailing()
+			Eventually(doneChan).Should(BeClosed())
+		})
+
+		It("reports giving up and returns when the consumer stops retrying", func() {
+			close(consumer.giveUp)
+
+			Eventually(doneChan).Should(BeClosed())
+			Expect(receiver.GetStates()).To(Equal([]logs.ConnectionState{logs.GaveUp}))
+		})
+
+		It("does not report giving up when stopped", func() {
+			logReader.StopTailing()
+
+			Eventually(doneChan).Should(BeClosed())
+			Consistently(receiver.GetStates).Should(BeEmpty())
 		})
 	})
 
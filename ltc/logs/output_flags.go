@@ -0,0 +1,61 @@
+package logs
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler"
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terminal"
+	"github.com/codegangsta/cli"
+)
+
+const (
+	defaultOutputMaxMB   = 100
+	defaultOutputBackups = 5
+)
+
+// OutputFlags are the flags of commands that can write what they stream,
+// e.g. "logs" or "envelopes", to a rotating file instead of the terminal.
+func OutputFlags(streamed string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: fmt.Sprintf("Write %s to this file instead of the terminal", streamed),
+		},
+		cli.IntFlag{
+			Name:  "output-max-mb",
+			Usage: "Rotate the --output file when it reaches this size in MB (0 disables rotation)",
+			Value: defaultOutputMaxMB,
+		},
+		cli.IntFlag{
+			Name:  "output-backups",
+			Usage: "Number of rotated --output files to keep",
+			Value: defaultOutputBackups,
+		},
+	}
+}
+
+// OpenOutputFile opens the file given by the OutputFlags, or returns nil when
+// there is none. When the flags are invalid or the file cannot be opened, it
+// reports the error, exits and returns false.
+func OpenOutputFile(context *cli.Context, ui terminal.UI, exitHandler exit_handler.ExitHandler) (*RotatingFileWriter, bool) {
+	outputPath := context.String("output")
+	if outputPath == "" {
+		return nil, true
+	}
+
+	if context.Int("output-max-mb") < 0 || context.Int("output-backups") < 0 {
+		ui.IncorrectUsage("--output-max-mb and --output-backups must not be negative")
+		exitHandler.Exit(exit_codes.InvalidSyntax)
+		return nil, false
+	}
+
+	outputFile, err := NewRotatingFileWriter(outputPath, int64(context.Int("output-max-mb"))*1024*1024, context.Int("output-backups"))
+	if err != nil {
+		ui.SayError(fmt.Sprintf("Error opening %s: %s", outputPath, err))
+		exitHandler.Exit(exit_codes.GeneralError)
+		return nil, false
+	}
+
+	return outputFile, true
+}
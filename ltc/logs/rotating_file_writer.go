@@ -0,0 +1,94 @@
+package logs
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// RotatingFileWriter appends to a file, moving it aside to FILE.1, FILE.2, ...
+// once it would grow past maxBytes. At most maxBackups old files are kept. A
+// maxBytes of zero disables rotation.
+type RotatingFileWriter struct {
+	sync.Mutex
+	path       string
+	maxBytes   int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func NewRotatingFileWriter(path string, maxBytes int64, maxBackups int) (*RotatingFileWriter, error) {
+	writer := &RotatingFileWriter{
+		path:       path,
+		maxBytes:   maxBytes,
+		maxBackups: maxBackups,
+	}
+
+	if err := writer.open(); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (writer *RotatingFileWriter) Write(p []byte) (int, error) {
+	writer.Lock()
+	defer writer.Unlock()
+
+	if writer.maxBytes > 0 && writer.size > 0 && writer.size+int64(len(p)) > writer.maxBytes {
+		if err := writer.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := writer.file.Write(p)
+	writer.size += int64(n)
+	return n, err
+}
+
+func (writer *RotatingFileWriter) Close() error {
+	writer.Lock()
+	defer writer.Unlock()
+
+	return writer.file.Close()
+}
+
+func (writer *RotatingFileWriter) open() error {
+	file, err := os.OpenFile(writer.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	writer.file = file
+	writer.size = info.Size()
+	return nil
+}
+
+func (writer *RotatingFileWriter) rotate() error {
+	if err := writer.file.Close(); err != nil {
+		return err
+	}
+
+	if writer.maxBackups > 0 {
+		for backup := writer.maxBackups - 1; backup > 0; backup-- {
+			os.Rename(writer.backupPath(backup), writer.backupPath(backup+1))
+		}
+		if err := os.Rename(writer.path, writer.backupPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(writer.path); err != nil {
+		return err
+	}
+
+	return writer.open()
+}
+
+func (writer *RotatingFileWriter) backupPath(backup int) string {
+	return fmt.Sprintf("%s.%d", writer.path, backup)
+}
@@ -0,0 +1,83 @@
+package logs_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/logs"
+)
+
+var _ = Describe("RotatingFileWriter", func() {
+	var (
+		tmpDir  string
+		logPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rotating-file-writer")
+		Expect(err).ToNot(HaveOccurred())
+		logPath = filepath.Join(tmpDir, "app.log")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	It("appends to an existing file", func() {
+		Expect(ioutil.WriteFile(logPath, []byte("old\n"), 0644)).To(Succeed())
+
+		writer, err := logs.NewRotatingFileWriter(logPath, 0, 0)
+		Expect(err).ToNot(HaveOccurred())
+		writer.Write([]byte("new\n"))
+		Expect(writer.Close()).To(Succeed())
+
+		Expect(readFile(logPath)).To(Equal("old\nnew\n"))
+	})
+
+	It("rotates the file when it would grow past the maximum size, keeping the given number of backups", func() {
+		writer, err := logs.NewRotatingFileWriter(logPath, 6, 2)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, line := range []string{"one\n", "two\n", "three\n", "four\n"} {
+			_, err := writer.Write([]byte(line))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(writer.Close()).To(Succeed())
+
+		Expect(readFile(logPath)).To(Equal("four\n"))
+		Expect(readFile(logPath + ".1")).To(Equal("three\n"))
+		Expect(readFile(logPath + ".2")).To(Equal("two\n"))
+
+		_, err = os.Stat(logPath + ".3")
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("truncates the file on rotation when no backups are kept", func() {
+		writer, err := logs.NewRotatingFileWriter(logPath, 6, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		writer.Write([]byte("one\n"))
+		writer.Write([]byte("two\n"))
+		Expect(writer.Close()).To(Succeed())
+
+		Expect(readFile(logPath)).To(Equal("two\n"))
+		_, err = os.Stat(logPath + ".1")
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("returns an error when the file cannot be opened", func() {
+		_, err := logs.NewRotatingFileWriter(filepath.Join(tmpDir, "missing", "app.log"), 0, 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -3,11 +3,18 @@ package main
 import (
 	"os"
 
+	"github.com/cloudfoundry-incubator/lattice/ltc/exit_handler/exit_codes"
 	"github.com/cloudfoundry-incubator/lattice/ltc/setup_cli"
 )
 
 func main() {
-	defer os.Stdout.Write([]byte("\n"))
-	cliApp := setup_cli.NewCliApp()
-	cliApp.Run(os.Args)
+	cliApp, exitHandler := setup_cli.NewCliApp()
+	err := cliApp.Run(os.Args)
+	os.Stdout.Write([]byte("\n"))
+
+	exitCode := 0
+	if err != nil {
+		exitCode = exit_codes.InvalidSyntax
+	}
+	exitHandler.Exit(exitCode)
 }
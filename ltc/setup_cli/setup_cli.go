@@ -24,17 +24,17 @@ var (
 	latticeVersion string // provided by linker argument at compile-time
 )
 
-func NewCliApp() *cli.App {
+func NewCliApp() (*cli.App, exit_handler.ExitHandler) {
 	config := config.New(persister.NewFilePersister(config_helpers.ConfigFileLocation(ltcConfigRoot())))
 
-	signalChan := make(chan os.Signal)
-	signal.Notify(signalChan, os.Interrupt)
-	exitHandler := exit_handler.New(signalChan, os.Exit)
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, exit_handler.ExitSignals...)
+	exitHandler := exit_handler.New(signalChan, os.Exit, os.Stderr)
 	go exitHandler.Run()
 
 	targetVerifier := target_verifier.New(receptor_client_factory.MakeReceptorClient)
-	app := cli_app_factory.MakeCliApp(os.Getenv(timeoutVar), latticeVersion, ltcConfigRoot(), exitHandler, config, logger(), targetVerifier, os.Stdout)
-	return app
+	app := cli_app_factory.MakeCliApp(os.Getenv(timeoutVar), latticeVersion, ltcConfigRoot(), exitHandler, config, logger(), targetVerifier, os.Stdout, os.Stderr)
+	return app, exitHandler
 }
 
 func logger() lager.Logger {
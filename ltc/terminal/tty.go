@@ -0,0 +1,15 @@
+package terminal
+
+import (
+	"io"
+	"os"
+
+	"github.com/docker/docker/pkg/term"
+)
+
+// IsTTY reports whether output is a terminal, so that colors can be turned
+// off when output is redirected to a file or a pipe.
+func IsTTY(output io.Writer) bool {
+	file, ok := output.(*os.File)
+	return ok && term.IsTerminal(file.Fd())
+}
@@ -12,6 +12,7 @@ import (
 type UI interface {
 	Say(message string)
 	SayLine(message string)
+	SayError(message string)
 	IncorrectUsage(message string)
 	Dot()
 	NewLine()
@@ -25,13 +26,15 @@ type terminalUI struct {
 	io.Reader
 	io.Writer
 	password_reader.PasswordReader
+	errorOutput io.Writer
 }
 
-func NewUI(input io.Reader, output io.Writer, passwordReader password_reader.PasswordReader) UI {
+func NewUI(input io.Reader, output, errorOutput io.Writer, passwordReader password_reader.PasswordReader) UI {
 	return &terminalUI{
 		input,
 		output,
 		passwordReader,
+		errorOutput,
 	}
 }
 
@@ -43,11 +46,16 @@ func (t *terminalUI) SayLine(message string) {
 	t.Write([]byte(message + "\n"))
 }
 
+// SayError writes the message and a newline to the error output.
+func (t *terminalUI) SayError(message string) {
+	t.errorOutput.Write([]byte(message + "\n"))
+}
+
 func (t *terminalUI) IncorrectUsage(message string) {
 	if len(message) > 0 {
-		t.Say("Incorrect Usage: " + message)
+		t.SayError("Incorrect Usage: " + message)
 	} else {
-		t.Say("Incorrect Usage")
+		t.SayError("Incorrect Usage")
 	}
 }
 
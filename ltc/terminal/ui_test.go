@@ -18,6 +18,7 @@ var _ = Describe("UI", func() {
 		stdinReader        *io.PipeReader
 		stdinWriter        *io.PipeWriter
 		outputBuffer       *gbytes.Buffer
+		errorBuffer        *gbytes.Buffer
 		fakePasswordReader *fake_password_reader.FakePasswordReader
 		terminalUI         terminal.UI
 	)
@@ -25,8 +26,9 @@ var _ = Describe("UI", func() {
 	BeforeEach(func() {
 		stdinReader, stdinWriter = io.Pipe()
 		outputBuffer = gbytes.NewBuffer()
+		errorBuffer = gbytes.NewBuffer()
 		fakePasswordReader = &fake_password_reader.FakePasswordReader{}
-		terminalUI = terminal.NewUI(stdinReader, outputBuffer, fakePasswordReader)
+		terminalUI = terminal.NewUI(stdinReader, outputBuffer, errorBuffer, fakePasswordReader)
 	})
 
 	Describe("Instantiation", func() {
@@ -56,17 +58,25 @@ var _ = Describe("UI", func() {
 			})
 		})
 
+		Describe("SayError", func() {
+			It("says the message to the error output with a newline", func() {
+				terminalUI.SayError("Cloudy with a chance of errors")
+				Expect(errorBuffer).To(test_helpers.Say("Cloudy with a chance of errors\n"))
+				Expect(outputBuffer.Contents()).To(BeEmpty())
+			})
+		})
+
 		Describe("IncorrectUsage", func() {
 			Context("when no message is passed", func() {
 				It("outputs incorrect usage", func() {
 					terminalUI.IncorrectUsage("")
-					Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage"))
+					Expect(errorBuffer).To(test_helpers.Say("Incorrect Usage"))
 				})
 			})
 			Context("when a message is passed", func() {
 				It("outputs incorrect usage with the message", func() {
 					terminalUI.IncorrectUsage("You did that thing wrong")
-					Expect(outputBuffer).To(test_helpers.Say("Incorrect Usage: You did that thing wrong"))
+					Expect(errorBuffer).To(test_helpers.Say("Incorrect Usage: You did that thing wrong"))
 				})
 			})
 		})
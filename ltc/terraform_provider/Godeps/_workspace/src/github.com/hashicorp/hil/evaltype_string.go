@@ -0,0 +1,42 @@
+// Code generated by "stringer -type=EvalType eval_type.go"; DO NOT EDIT
+
+package hil
+
+import "fmt"
+
+const (
+	_EvalType_name_0 = "TypeInvalid"
+	_EvalType_name_1 = "TypeString"
+	_EvalType_name_2 = "TypeBool"
+	_EvalType_name_3 = "TypeList"
+	_EvalType_name_4 = "TypeMap"
+	_EvalType_name_5 = "TypeUnknown"
+)
+
+var (
+	_EvalType_index_0 = [...]uint8{0, 11}
+	_EvalType_index_1 = [...]uint8{0, 10}
+	_EvalType_index_2 = [...]uint8{0, 8}
+	_EvalType_index_3 = [...]uint8{0, 8}
+	_EvalType_index_4 = [...]uint8{0, 7}
+	_EvalType_index_5 = [...]uint8{0, 11}
+)
+
+func (i EvalType) String() string {
+	switch {
+	case i == 0:
+		return _EvalType_name_0
+	case i == 2:
+		return _EvalType_name_1
+	case i == 4:
+		return _EvalType_name_2
+	case i == 8:
+		return _EvalType_name_3
+	case i == 16:
+		return _EvalType_name_4
+	case i == 32:
+		return _EvalType_name_5
+	default:
+		return fmt.Sprintf("EvalType(%d)", i)
+	}
+}
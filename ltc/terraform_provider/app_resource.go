@@ -0,0 +1,410 @@
+package terraform_provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_repository_name_formatter"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	DefaultPort = 8080
+
+	MustSetMonitoredPortErrorMessage = "monitored_port must be set when more than one port is exposed"
+)
+
+// appResourceSchema describes lattice_app. Its arguments and defaults follow
+// the flags of ltc create. Only instances and routes can be changed in place;
+// changing any other argument replaces the app.
+func appResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return meta.(*AppResource).Create(d)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return meta.(*AppResource).Read(d)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return meta.(*AppResource).Update(d)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return meta.(*AppResource).Delete(d)
+		},
+		Exists: func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return meta.(*AppResource).Exists(d)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"docker_image": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_command": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"args": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"env": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"run_as_root": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"cpu_weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ForceNew:     true,
+				ValidateFunc: validateCPUWeight,
+			},
+			"memory_mb": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
+				ForceNew: true,
+			},
+			"disk_mb": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
+				ForceNew: true,
+			},
+			"ports": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"monitored_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"no_monitor": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"working_dir": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+			"routes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname_prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"running_instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type AppResource struct {
+	appRunner    docker_app_runner.AppRunner
+	appExaminer  app_examiner.AppExaminer
+	systemDomain string
+}
+
+func NewAppResource(appRunner docker_app_runner.AppRunner, appExaminer app_examiner.AppExaminer, systemDomain string) *AppResource {
+	return &AppResource{
+		appRunner:    appRunner,
+		appExaminer:  appExaminer,
+		systemDomain: systemDomain,
+	}
+}
+
+func (r *AppResource) Create(d *schema.ResourceData) error {
+	portConfig, err := portConfig(d)
+	if err != nil {
+		return err
+	}
+
+	params := docker_app_runner.CreateDockerAppParams{
+		Name:                 d.Get("name").(string),
+		DockerImagePath:      d.Get("docker_image").(string),
+		StartCommand:         d.Get("start_command").(string),
+		AppArgs:              stringList(d.Get("args")),
+		EnvironmentVariables: stringMap(d.Get("env")),
+		Privileged:           d.Get("run_as_root").(bool),
+		Monitor:              !d.Get("no_monitor").(bool),
+		Instances:            d.Get("instances").(int),
+		CPUWeight:            uint(d.Get("cpu_weight").(int)),
+		MemoryMB:             d.Get("memory_mb").(int),
+		DiskMB:               d.Get("disk_mb").(int),
+		Ports:                portConfig,
+		WorkingDir:           d.Get("working_dir").(string),
+		RouteOverrides:       routeOverrides(d.Get("routes")),
+	}
+
+	if err := r.appRunner.CreateDockerApp(params); err != nil {
+		return err
+	}
+
+	d.SetId(params.Name)
+	return r.Read(d)
+}
+
+// Read refreshes every argument from the cluster, clearing the id when the
+// app has been removed outside of Terraform.
+func (r *AppResource) Read(d *schema.ResourceData) error {
+	appInfo, err := r.appExaminer.AppStatus(d.Id())
+	if _, ok := err.(app_examiner.AppNotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ports := []interface{}{}
+	for _, port := range appInfo.Ports {
+		ports = append(ports, int(port))
+	}
+
+	env := map[string]interface{}{}
+	for _, envVar := range appInfo.EnvironmentVariables {
+		if envVar.Name == "PORT" {
+			continue
+		}
+		env[envVar.Name] = envVar.Value
+	}
+
+	args := []interface{}{}
+	for _, arg := range appInfo.StartArgs {
+		args = append(args, arg)
+	}
+
+	attrs := []struct {
+		key   string
+		value interface{}
+	}{
+		{"name", appInfo.ProcessGuid},
+		{"docker_image", dockerImage(d.Get("docker_image").(string), appInfo.RootFS)},
+		{"start_command", appInfo.StartCommand},
+		{"args", args},
+		{"env", env},
+		{"run_as_root", appInfo.RunAsRoot},
+		{"instances", appInfo.DesiredInstances},
+		{"running_instances", appInfo.ActualRunningInstances},
+		{"cpu_weight", int(appInfo.CPUWeight)},
+		{"memory_mb", appInfo.MemoryMB},
+		{"disk_mb", appInfo.DiskMB},
+		{"ports", ports},
+		{"monitored_port", int(appInfo.MonitoredPort)},
+		{"no_monitor", appInfo.MonitoredPort == 0},
+		{"working_dir", appInfo.WorkingDir},
+		{"routes", r.flattenRoutes(appInfo)},
+	}
+	for _, attr := range attrs {
+		if err := d.Set(attr.key, attr.value); err != nil {
+			return fmt.Errorf("error setting %s: %s", attr.key, err)
+		}
+	}
+
+	return nil
+}
+
+// Update scales and re-routes the app. If re-routing fails after scaling
+// succeeded, the new instance count is still saved to the state.
+func (r *AppResource) Update(d *schema.ResourceData) error {
+	d.Partial(true)
+
+	if d.HasChange("instances") {
+		if err := r.appRunner.ScaleApp(d.Id(), d.Get("instances").(int)); err != nil {
+			return err
+		}
+		d.SetPartial("instances")
+	}
+
+	if d.HasChange("routes") {
+		if err := r.appRunner.UpdateAppRoutes(d.Id(), routeOverrides(d.Get("routes"))); err != nil {
+			return err
+		}
+		d.SetPartial("routes")
+	}
+
+	d.Partial(false)
+	return r.Read(d)
+}
+
+// Delete removes the app. An app that is already gone is not an error.
+func (r *AppResource) Delete(d *schema.ResourceData) error {
+	exists, err := r.Exists(d)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if err := r.appRunner.RemoveApp(d.Id()); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Exists reports whether the app is still desired, so that an app scaled to
+// zero or whose instances are gone stays in the state.
+func (r *AppResource) Exists(d *schema.ResourceData) (bool, error) {
+	if _, err := r.appExaminer.AppStatus(d.Id()); err != nil {
+		if _, ok := err.(app_examiner.AppNotFoundError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// dockerImage keeps the configured image when it names the app's rootfs, so
+// that "redis" is not replaced by "library/redis:latest" on every refresh.
+func dockerImage(configured, rootFS string) string {
+	if formatted, err := docker_repository_name_formatter.FormatForReceptor(configured); err == nil && formatted == rootFS {
+		return configured
+	}
+
+	rootFSURL, err := url.Parse(rootFS)
+	if err != nil || rootFSURL.Scheme != docker_repository_name_formatter.DockerScheme {
+		return rootFS
+	}
+
+	image := strings.TrimPrefix(rootFSURL.Host+rootFSURL.Path, "/")
+	if rootFSURL.Fragment != "" {
+		image += ":" + rootFSURL.Fragment
+	}
+	return image
+}
+
+func (r *AppResource) flattenRoutes(appInfo app_examiner.AppInfo) []interface{} {
+	routes := []interface{}{}
+	suffix := "." + r.systemDomain
+
+	for _, appRoute := range appInfo.Routes {
+		hostnames := append([]string{}, appRoute.Hostnames...)
+		sort.Strings(hostnames)
+
+		for _, hostname := range hostnames {
+			routes = append(routes, map[string]interface{}{
+				"hostname_prefix": strings.TrimSuffix(hostname, suffix),
+				"port":            int(appRoute.Port),
+			})
+		}
+	}
+
+	return routes
+}
+
+// portConfig exposes 8080 and monitors the only exposed port unless told
+// otherwise, as ltc create does for images without exposed ports.
+func portConfig(d *schema.ResourceData) (docker_app_runner.PortConfig, error) {
+	noMonitor := d.Get("no_monitor").(bool)
+
+	portConfig := docker_app_runner.PortConfig{}
+	for _, port := range d.Get("ports").([]interface{}) {
+		portConfig.Exposed = append(portConfig.Exposed, uint16(port.(int)))
+	}
+	if len(portConfig.Exposed) == 0 {
+		portConfig.Exposed = []uint16{DefaultPort}
+	}
+
+	if noMonitor {
+		return portConfig, nil
+	}
+
+	portConfig.Monitored = uint16(d.Get("monitored_port").(int))
+	if portConfig.Monitored == 0 {
+		if len(portConfig.Exposed) > 1 {
+			return docker_app_runner.PortConfig{}, errors.New(MustSetMonitoredPortErrorMessage)
+		}
+		portConfig.Monitored = portConfig.Exposed[0]
+	}
+
+	return portConfig, nil
+}
+
+func routeOverrides(rawRoutes interface{}) docker_app_runner.RouteOverrides {
+	routes := docker_app_runner.RouteOverrides{}
+	for _, rawRoute := range rawRoutes.([]interface{}) {
+		route := rawRoute.(map[string]interface{})
+		routes = append(routes, docker_app_runner.RouteOverride{
+			HostnamePrefix: route["hostname_prefix"].(string),
+			Port:           uint16(route["port"].(int)),
+		})
+	}
+
+	if len(routes) == 0 {
+		return nil
+	}
+	return routes
+}
+
+func stringList(rawValues interface{}) []string {
+	values := []string{}
+	for _, rawValue := range rawValues.([]interface{}) {
+		values = append(values, rawValue.(string))
+	}
+	return values
+}
+
+func stringMap(rawValues interface{}) map[string]string {
+	values := map[string]string{}
+	for name, rawValue := range rawValues.(map[string]interface{}) {
+		values[name] = rawValue.(string)
+	}
+	return values
+}
+
+func validateCPUWeight(value interface{}, key string) ([]string, []error) {
+	if cpuWeight := value.(int); cpuWeight < 1 || cpuWeight > 100 {
+		return nil, []error{fmt.Errorf("%s must be between 1 and 100", key)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,378 @@
+package terraform_provider_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner/fake_app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner/fake_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/route_helpers"
+	"github.com/cloudfoundry-incubator/lattice/ltc/terraform_provider"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var _ = Describe("AppResource", func() {
+	var (
+		appRunner   *fake_app_runner.FakeAppRunner
+		appExaminer *fake_app_examiner.FakeAppExaminer
+		provider    *schema.Provider
+		info        *terraform.InstanceInfo
+	)
+
+	resourceConfig := func(raw map[string]interface{}) *terraform.ResourceConfig {
+		rawConfig, err := config.NewRawConfig(raw)
+		Expect(err).ToNot(HaveOccurred())
+		return terraform.NewResourceConfig(rawConfig)
+	}
+
+	diff := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceDiff {
+		instanceDiff, err := provider.Diff(info, state, resourceConfig(raw))
+		Expect(err).ToNot(HaveOccurred())
+		return instanceDiff
+	}
+
+	apply := func(state *terraform.InstanceState, raw map[string]interface{}) (*terraform.InstanceState, error) {
+		return provider.Apply(info, state, diff(state, raw))
+	}
+
+	BeforeEach(func() {
+		appRunner = &fake_app_runner.FakeAppRunner{}
+		appExaminer = &fake_app_examiner.FakeAppExaminer{}
+		provider = terraform_provider.Provider().(*schema.Provider)
+		provider.SetMeta(terraform_provider.NewAppResource(appRunner, appExaminer, "lattice.example.com"))
+		info = &terraform.InstanceInfo{Type: "lattice_app"}
+
+		appExaminer.AppStatusReturns(app_examiner.AppInfo{
+			ProcessGuid:            "cool-web-app",
+			DesiredInstances:       3,
+			ActualRunningInstances: 2,
+			CPUWeight:              100,
+			MemoryMB:               128,
+			DiskMB:                 1024,
+			Ports:                  []uint16{8080},
+			EnvironmentVariables: []app_examiner.EnvironmentVariable{
+				{Name: "TIMEZONE", Value: "PST"},
+				{Name: "PORT", Value: "8080"},
+			},
+			Routes: route_helpers.AppRoutes{
+				{Hostnames: []string{"cool-web-app.lattice.example.com", "cool.lattice.example.com"}, Port: 8080},
+			},
+			RootFS:        "docker:///cool/web-app#latest",
+			StartCommand:  "/start-me-please",
+			StartArgs:     []string{"AppArg0"},
+			WorkingDir:    "/applications",
+			RunAsRoot:     true,
+			MonitoredPort: 8080,
+		}, nil)
+	})
+
+	Describe("Create", func() {
+		It("creates the app and reads back its state", func() {
+			state, err := apply(nil, map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+				"args":          []interface{}{"AppArg0", "--appFlavor=\"purple\""},
+				"env":           map[string]interface{}{"TIMEZONE": "PST"},
+				"run_as_root":   true,
+				"instances":     3,
+				"cpu_weight":    100,
+				"memory_mb":     128,
+				"disk_mb":       1024,
+				"ports":         []interface{}{8080},
+				"working_dir":   "/applications",
+				"routes": []interface{}{
+					map[string]interface{}{"hostname_prefix": "cool", "port": 8080},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.CreateDockerAppCallCount()).To(Equal(1))
+			Expect(appRunner.CreateDockerAppArgsForCall(0)).To(Equal(docker_app_runner.CreateDockerAppParams{
+				Name:                 "cool-web-app",
+				DockerImagePath:      "cool/web-app",
+				StartCommand:         "/start-me-please",
+				AppArgs:              []string{"AppArg0", "--appFlavor=\"purple\""},
+				EnvironmentVariables: map[string]string{"TIMEZONE": "PST"},
+				Privileged:           true,
+				Monitor:              true,
+				Instances:            3,
+				CPUWeight:            100,
+				MemoryMB:             128,
+				DiskMB:               1024,
+				Ports:                docker_app_runner.PortConfig{Monitored: 8080, Exposed: []uint16{8080}},
+				WorkingDir:           "/applications",
+				RouteOverrides:       docker_app_runner.RouteOverrides{{HostnamePrefix: "cool", Port: 8080}},
+			}))
+
+			Expect(state.ID).To(Equal("cool-web-app"))
+			Expect(state.Attributes["docker_image"]).To(Equal("cool/web-app"))
+			Expect(state.Attributes["running_instances"]).To(Equal("2"))
+		})
+
+		It("defaults to the settings of ltc create, exposing and monitoring 8080", func() {
+			_, err := apply(nil, map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.CreateDockerAppArgsForCall(0)).To(Equal(docker_app_runner.CreateDockerAppParams{
+				Name:                 "cool-web-app",
+				DockerImagePath:      "cool/web-app",
+				StartCommand:         "/start-me-please",
+				AppArgs:              []string{},
+				EnvironmentVariables: map[string]string{},
+				Monitor:              true,
+				Instances:            1,
+				CPUWeight:            100,
+				MemoryMB:             128,
+				DiskMB:               1024,
+				Ports:                docker_app_runner.PortConfig{Monitored: 8080, Exposed: []uint16{8080}},
+				WorkingDir:           "/",
+			}))
+		})
+
+		It("exposes 8080 without monitoring it when monitoring is off", func() {
+			_, err := apply(nil, map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+				"no_monitor":    true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			params := appRunner.CreateDockerAppArgsForCall(0)
+			Expect(params.Monitor).To(BeFalse())
+			Expect(params.Ports).To(Equal(docker_app_runner.PortConfig{Exposed: []uint16{8080}}))
+		})
+
+		It("monitors the configured port", func() {
+			_, err := apply(nil, map[string]interface{}{
+				"name":           "cool-web-app",
+				"docker_image":   "cool/web-app",
+				"start_command":  "/start-me-please",
+				"ports":          []interface{}{8080, 9090},
+				"monitored_port": 9090,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.CreateDockerAppArgsForCall(0).Ports).To(Equal(docker_app_runner.PortConfig{Monitored: 9090, Exposed: []uint16{8080, 9090}}))
+		})
+
+		It("requires a monitored port when several ports are exposed", func() {
+			_, err := apply(nil, map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+				"ports":         []interface{}{8080, 9090},
+			})
+
+			Expect(err).To(MatchError(ContainSubstring(terraform_provider.MustSetMonitoredPortErrorMessage)))
+			Expect(appRunner.CreateDockerAppCallCount()).To(BeZero())
+		})
+
+		It("returns errors from creating the app without saving it to the state", func() {
+			appRunner.CreateDockerAppReturns(errors.New("major fail"))
+
+			state, err := apply(nil, map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+			})
+
+			Expect(err).To(MatchError("major fail"))
+			Expect(state).To(BeNil())
+		})
+	})
+
+	Describe("Read", func() {
+		It("sets every argument from the app status", func() {
+			state, err := provider.Refresh(info, &terraform.InstanceState{ID: "cool-web-app"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appExaminer.AppStatusArgsForCall(0)).To(Equal("cool-web-app"))
+			Expect(state.Attributes).To(Equal(map[string]string{
+				"id":                       "cool-web-app",
+				"name":                     "cool-web-app",
+				"docker_image":             "cool/web-app:latest",
+				"start_command":            "/start-me-please",
+				"args.#":                   "1",
+				"args.0":                   "AppArg0",
+				"env.#":                    "1",
+				"env.TIMEZONE":             "PST",
+				"run_as_root":              "true",
+				"instances":                "3",
+				"running_instances":        "2",
+				"cpu_weight":               "100",
+				"memory_mb":                "128",
+				"disk_mb":                  "1024",
+				"ports.#":                  "1",
+				"ports.0":                  "8080",
+				"monitored_port":           "8080",
+				"no_monitor":               "false",
+				"working_dir":              "/applications",
+				"routes.#":                 "2",
+				"routes.0.hostname_prefix": "cool-web-app",
+				"routes.0.port":            "8080",
+				"routes.1.hostname_prefix": "cool",
+				"routes.1.port":            "8080",
+			}))
+		})
+
+		It("keeps the configured docker image when it names the same image", func() {
+			state, err := provider.Refresh(info, &terraform.InstanceState{
+				ID:         "cool-web-app",
+				Attributes: map[string]string{"docker_image": "cool/web-app"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(state.Attributes["docker_image"]).To(Equal("cool/web-app"))
+		})
+
+		It("removes the app from the state when it no longer exists", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			state, err := provider.Refresh(info, &terraform.InstanceState{ID: "cool-web-app"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(state).To(BeNil())
+		})
+
+		It("keeps an app that has no instances in the state", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{ProcessGuid: "cool-web-app", RootFS: "docker:///cool/web-app#latest"}, nil)
+
+			state, err := provider.Refresh(info, &terraform.InstanceState{ID: "cool-web-app"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(state).ToNot(BeNil())
+			Expect(state.Attributes["instances"]).To(Equal("0"))
+		})
+
+		It("returns errors from examining the app", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, errors.New("receptor down"))
+
+			_, err := provider.Refresh(info, &terraform.InstanceState{ID: "cool-web-app"})
+			Expect(err).To(MatchError("receptor down"))
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			state     *terraform.InstanceState
+			appConfig map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			appConfig = map[string]interface{}{
+				"name":          "cool-web-app",
+				"docker_image":  "cool/web-app",
+				"start_command": "/start-me-please",
+				"args":          []interface{}{"AppArg0"},
+				"env":           map[string]interface{}{"TIMEZONE": "PST"},
+				"run_as_root":   true,
+				"instances":     3,
+				"working_dir":   "/applications",
+			}
+
+			var err error
+			state, err = apply(nil, appConfig)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("has nothing to change when the app matches its configuration", func() {
+			Expect(diff(state, appConfig)).To(BeNil())
+		})
+
+		It("scales the app in place when instances change", func() {
+			appConfig["instances"] = 5
+
+			Expect(diff(state, appConfig).RequiresNew()).To(BeFalse())
+			_, err := apply(state, appConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.ScaleAppCallCount()).To(Equal(1))
+			name, instances := appRunner.ScaleAppArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(instances).To(Equal(5))
+			Expect(appRunner.UpdateAppRoutesCallCount()).To(BeZero())
+		})
+
+		It("updates the routes in place when they change", func() {
+			appConfig["routes"] = []interface{}{
+				map[string]interface{}{"hostname_prefix": "new-route", "port": 9090},
+			}
+
+			Expect(diff(state, appConfig).RequiresNew()).To(BeFalse())
+			_, err := apply(state, appConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.UpdateAppRoutesCallCount()).To(Equal(1))
+			name, routes := appRunner.UpdateAppRoutesArgsForCall(0)
+			Expect(name).To(Equal("cool-web-app"))
+			Expect(routes).To(Equal(docker_app_runner.RouteOverrides{{HostnamePrefix: "new-route", Port: 9090}}))
+			Expect(appRunner.ScaleAppCallCount()).To(BeZero())
+		})
+
+		It("replaces the app when any other argument changes", func() {
+			appConfig["docker_image"] = "cool/web-app:v2"
+
+			Expect(diff(state, appConfig).RequiresNew()).To(BeTrue())
+		})
+
+		It("returns errors from scaling the app", func() {
+			appRunner.ScaleAppReturns(errors.New("major fail"))
+			appConfig["instances"] = 5
+
+			_, err := apply(state, appConfig)
+			Expect(err).To(MatchError("major fail"))
+		})
+	})
+
+	Describe("Delete", func() {
+		destroy := func() (*terraform.InstanceState, error) {
+			return provider.Apply(info, &terraform.InstanceState{ID: "cool-web-app"}, &terraform.InstanceDiff{Destroy: true})
+		}
+
+		It("removes the app", func() {
+			state, err := destroy()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.RemoveAppCallCount()).To(Equal(1))
+			Expect(appRunner.RemoveAppArgsForCall(0)).To(Equal("cool-web-app"))
+			Expect(state).To(BeNil())
+		})
+
+		It("removes an app that has no instances", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{ProcessGuid: "cool-web-app"}, nil)
+
+			_, err := destroy()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.RemoveAppCallCount()).To(Equal(1))
+		})
+
+		It("does nothing when the app is already gone", func() {
+			appExaminer.AppStatusReturns(app_examiner.AppInfo{}, app_examiner.AppNotFoundError("cool-web-app"))
+
+			_, err := destroy()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(appRunner.RemoveAppCallCount()).To(BeZero())
+		})
+
+		It("returns errors from removing the app", func() {
+			appRunner.RemoveAppReturns(errors.New("major fail"))
+
+			_, err := destroy()
+			Expect(err).To(MatchError("major fail"))
+		})
+	})
+})
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/cloudfoundry-incubator/lattice/ltc/terraform_provider"
+	"github.com/hashicorp/terraform/plugin"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeOpts{
+		ProviderFunc: terraform_provider.Provider,
+	})
+}
@@ -0,0 +1,121 @@
+// Package terraform_provider is the Terraform provider for Lattice. Its
+// lattice_app resource deploys apps in the same plan as the cluster built by
+// terraform/aws, terraform/digitalocean and terraform/google, and
+// terraform-provider-lattice serves it as a Terraform plugin.
+package terraform_provider
+
+import (
+	"log"
+	"os"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_examiner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/app_runner/docker_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit"
+	"github.com/cloudfoundry-incubator/lattice/ltc/audit/audited_app_runner"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/config_helpers"
+	"github.com/cloudfoundry-incubator/lattice/ltc/config/persister"
+	"github.com/cloudfoundry-incubator/receptor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/pivotal-golang/clock"
+)
+
+const latticeCliHomeVar = "LATTICE_CLI_HOME"
+
+// Provider returns the Lattice provider. The target and credentials default
+// to $LATTICE_TARGET, $LATTICE_USERNAME and $LATTICE_PASSWORD, and apps are
+// audited to the same log and hook as ltc unless audit_log or audit_hook say
+// otherwise.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"target": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LATTICE_TARGET", nil),
+				Description: "The Lattice domain, e.g. the lattice_target output of the cluster templates.",
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LATTICE_USERNAME", ""),
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LATTICE_PASSWORD", ""),
+			},
+			"audit_log": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: defaultAuditLog,
+				Description: "Where to record the apps the provider changes. Defaults to the ltc audit log.",
+			},
+			"audit_hook": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(audit.HookVar, ""),
+				Description: "A command or webhook URL to send audit entries to, as for ltc.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"lattice_app": appResourceSchema(),
+		},
+
+		ConfigureFunc: func(d *schema.ResourceData) (interface{}, error) {
+			return Config{
+				Target:       d.Get("target").(string),
+				Username:     d.Get("username").(string),
+				Password:     d.Get("password").(string),
+				AuditLogPath: d.Get("audit_log").(string),
+				AuditHook:    d.Get("audit_hook").(string),
+			}.AppResource(), nil
+		},
+	}
+}
+
+// Config holds the provider block settings. Apps the provider changes are
+// recorded in the audit log at AuditLogPath and sent to AuditHook when they
+// are set, as ltc does.
+type Config struct {
+	Target       string
+	Username     string
+	Password     string
+	AuditLogPath string
+	AuditHook    string
+}
+
+// AppResource returns the lattice_app resource for the cluster the provider
+// is configured to target.
+func (c Config) AppResource() *AppResource {
+	ltcConfig := config.New(persister.NewMemPersister())
+	ltcConfig.SetTarget(c.Target)
+	ltcConfig.SetLogin(c.Username, c.Password)
+
+	receptorClient := receptor.NewClient(ltcConfig.Receptor())
+
+	appRunner := docker_app_runner.New(receptorClient, ltcConfig.Target())
+	if c.AuditLogPath != "" || c.AuditHook != "" {
+		auditor := audit.New(c.AuditLogPath, ltcConfig, audit.NewHook(c.AuditHook), clock.NewClock())
+		appRunner = audited_app_runner.New(appRunner, auditor, audit.SourceTerraform, func(err error) {
+			log.Printf("[WARN] %s", audit.Warning(err))
+		})
+	}
+
+	return NewAppResource(
+		appRunner,
+		app_examiner.New(receptorClient),
+		ltcConfig.Target(),
+	)
+}
+
+func defaultAuditLog() (interface{}, error) {
+	ltcHome := os.Getenv(latticeCliHomeVar)
+	if ltcHome == "" {
+		ltcHome = os.Getenv("HOME")
+	}
+
+	return config_helpers.AuditLogFileLocation(ltcHome), nil
+}
@@ -0,0 +1,13 @@
+package terraform_provider_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTerraformProvider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TerraformProvider Suite")
+}
@@ -0,0 +1,74 @@
+package terraform_provider_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/ltc/terraform_provider"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var _ = Describe("Provider", func() {
+	var provider *schema.Provider
+
+	resourceConfig := func(raw map[string]interface{}) *terraform.ResourceConfig {
+		rawConfig, err := config.NewRawConfig(raw)
+		Expect(err).ToNot(HaveOccurred())
+		return terraform.NewResourceConfig(rawConfig)
+	}
+
+	BeforeEach(func() {
+		provider = terraform_provider.Provider().(*schema.Provider)
+		os.Unsetenv("LATTICE_TARGET")
+	})
+
+	It("has a valid schema", func() {
+		Expect(provider.InternalValidate()).To(Succeed())
+	})
+
+	It("serves the lattice_app resource", func() {
+		Expect(provider.Resources()).To(ConsistOf(terraform.ResourceType{Name: "lattice_app"}))
+	})
+
+	It("configures the lattice_app resource for the target", func() {
+		err := provider.Configure(resourceConfig(map[string]interface{}{
+			"target":    "lattice.example.com",
+			"username":  "user",
+			"password":  "pass",
+			"audit_log": "",
+		}))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(provider.Meta()).To(BeAssignableToTypeOf(&terraform_provider.AppResource{}))
+	})
+
+	It("requires a target", func() {
+		_, errs := provider.Validate(resourceConfig(map[string]interface{}{}))
+
+		Expect(errs).To(ContainElement(MatchError(ContainSubstring("target"))))
+	})
+
+	It("reads the target from $LATTICE_TARGET", func() {
+		os.Setenv("LATTICE_TARGET", "lattice.example.com")
+		defer os.Unsetenv("LATTICE_TARGET")
+
+		_, errs := provider.Validate(resourceConfig(map[string]interface{}{}))
+
+		Expect(errs).To(BeEmpty())
+	})
+
+	It("rejects CPU weights that ltc create would reject", func() {
+		_, errs := provider.ValidateResource("lattice_app", resourceConfig(map[string]interface{}{
+			"name":          "cool-web-app",
+			"docker_image":  "cool/web-app",
+			"start_command": "/start-me-please",
+			"cpu_weight":    101,
+		}))
+
+		Expect(errs).To(ConsistOf(MatchError("cpu_weight must be between 1 and 100")))
+	})
+})
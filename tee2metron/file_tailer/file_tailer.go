@@ -0,0 +1,139 @@
+package file_tailer
+
+import (
+	"bytes"
+	"os"
+	"time"
+)
+
+// MaxLineLength is the longest line passed to the line callback; longer
+// lines are split.
+const MaxLineLength = 64 * 1024
+
+type fileTailer struct {
+	path         string
+	lineCallback func(string)
+	file         *os.File
+	info         os.FileInfo
+	offset       int64
+	partial      []byte
+}
+
+// Tail follows the file at path, calling lineCallback with each line appended
+// to it, until stopChan is closed. An existing file is followed from its end,
+// and a file that appears later from its start.
+//
+// The file is polled every pollInterval. When it is renamed and recreated, as
+// logrotate's create mode does, the rest of the old file is read before
+// switching to the new one; when it is truncated, as copytruncate does, it is
+// read again from the start. A missing file is waited for.
+func Tail(path string, pollInterval time.Duration, lineCallback func(string), stopChan <-chan struct{}) {
+	tailer := &fileTailer{path: path, lineCallback: lineCallback}
+	if tailer.open() {
+		tailer.offset, _ = tailer.file.Seek(0, os.SEEK_END)
+	}
+	defer tailer.close()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		tailer.poll()
+
+		select {
+		case <-stopChan:
+			tailer.readLines()
+			tailer.flushPartial()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *fileTailer) poll() {
+	if t.file == nil && !t.open() {
+		return
+	}
+
+	t.readLines()
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return
+	}
+
+	if !os.SameFile(info, t.info) {
+		t.flushPartial()
+		t.close()
+		if t.open() {
+			t.readLines()
+		}
+		return
+	}
+
+	if info.Size() < t.offset {
+		t.partial = nil
+		t.offset, _ = t.file.Seek(0, os.SEEK_SET)
+		t.readLines()
+	}
+}
+
+func (t *fileTailer) open() bool {
+	file, err := os.Open(t.path)
+	if err != nil {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return false
+	}
+
+	t.file, t.info, t.offset = file, info, 0
+	return true
+}
+
+func (t *fileTailer) close() {
+	if t.file != nil {
+		t.file.Close()
+		t.file = nil
+	}
+}
+
+func (t *fileTailer) readLines() {
+	if t.file == nil {
+		return
+	}
+
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := t.file.Read(buffer)
+		t.offset += int64(n)
+		t.partial = append(t.partial, buffer[:n]...)
+
+		for {
+			newline := bytes.IndexByte(t.partial, '\n')
+			if newline < 0 {
+				break
+			}
+			t.lineCallback(string(t.partial[:newline]))
+			t.partial = t.partial[newline+1:]
+		}
+		for len(t.partial) >= MaxLineLength {
+			t.lineCallback(string(t.partial[:MaxLineLength]))
+			t.partial = t.partial[MaxLineLength:]
+		}
+
+		if err != nil || n == 0 {
+			return
+		}
+	}
+}
+
+func (t *fileTailer) flushPartial() {
+	if len(t.partial) > 0 {
+		t.lineCallback(string(t.partial))
+		t.partial = nil
+	}
+}
@@ -0,0 +1,13 @@
+package file_tailer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestFileTailer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FileTailer Suite")
+}
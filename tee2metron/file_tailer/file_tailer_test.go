@@ -0,0 +1,130 @@
+package file_tailer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/file_tailer"
+)
+
+type lineReceiver struct {
+	sync.Mutex
+	lines []string
+}
+
+func (r *lineReceiver) AppendLine(line string) {
+	r.Lock()
+	defer r.Unlock()
+	r.lines = append(r.lines, line)
+}
+
+func (r *lineReceiver) GetLines() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string{}, r.lines...)
+}
+
+var _ = Describe("Tail", func() {
+	var (
+		tmpDir   string
+		logPath  string
+		receiver *lineReceiver
+		stopChan chan struct{}
+		doneChan chan struct{}
+	)
+
+	appendToFile := func(path, text string) {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+
+		_, err = file.WriteString(text)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	startTailing := func() {
+		stop, done := stopChan, doneChan
+		go func() {
+			file_tailer.Tail(logPath, 10*time.Millisecond, receiver.AppendLine, stop)
+			close(done)
+		}()
+
+		Consistently(receiver.GetLines).Should(BeEmpty())
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "file_tailer")
+		Expect(err).ToNot(HaveOccurred())
+
+		logPath = filepath.Join(tmpDir, "rep-service.log")
+		receiver = &lineReceiver{}
+		stopChan = make(chan struct{})
+		doneChan = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stopChan)
+		Eventually(doneChan).Should(BeClosed())
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("follows an existing file from its end", func() {
+		appendToFile(logPath, "old line\n")
+		startTailing()
+
+		appendToFile(logPath, "first line\nsecond ")
+		Eventually(receiver.GetLines).Should(Equal([]string{"first line"}))
+
+		appendToFile(logPath, "line\n")
+		Eventually(receiver.GetLines).Should(Equal([]string{"first line", "second line"}))
+	})
+
+	It("waits for a missing file and follows it from its start", func() {
+		startTailing()
+
+		appendToFile(logPath, "first line\n")
+		Eventually(receiver.GetLines).Should(Equal([]string{"first line"}))
+	})
+
+	It("finishes the old file and follows the new one when the file is rotated", func() {
+		appendToFile(logPath, "")
+		startTailing()
+		appendToFile(logPath, "before rotation\n")
+		Eventually(receiver.GetLines).Should(HaveLen(1))
+
+		appendToFile(logPath, "written while rotating\n")
+		Expect(os.Rename(logPath, logPath+".1")).To(Succeed())
+		appendToFile(logPath, "after rotation\n")
+
+		Eventually(receiver.GetLines).Should(Equal([]string{"before rotation", "written while rotating", "after rotation"}))
+	})
+
+	It("starts over when the file is truncated", func() {
+		appendToFile(logPath, "")
+		startTailing()
+		appendToFile(logPath, "before truncation\n")
+		Eventually(receiver.GetLines).Should(HaveLen(1))
+
+		Expect(os.Truncate(logPath, 0)).To(Succeed())
+		appendToFile(logPath, "after\n")
+
+		Eventually(receiver.GetLines).Should(Equal([]string{"before truncation", "after"}))
+	})
+
+	It("splits lines longer than MaxLineLength", func() {
+		appendToFile(logPath, "")
+		startTailing()
+
+		appendToFile(logPath, strings.Repeat("x", file_tailer.MaxLineLength+3)+"\n")
+
+		Eventually(receiver.GetLines).Should(Equal([]string{strings.Repeat("x", file_tailer.MaxLineLength), "xxx"}))
+	})
+})
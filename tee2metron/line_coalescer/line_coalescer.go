@@ -0,0 +1,104 @@
+package line_coalescer
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GoPanicContinuation matches the lines that follow the first line of a Go
+// panic: indented lines, blank lines, goroutine headers, function calls and
+// "created by" lines.
+var GoPanicContinuation = regexp.MustCompile(`^(\s|$|goroutine \d+ \[|created by |\[signal |\S+\(.*\)$)`)
+
+type LineCoalescer struct {
+	sync.Mutex
+	continuation    *regexp.Regexp
+	maxLines        int
+	flushTimeout    time.Duration
+	messageCallback func(string)
+	lines           []string
+	timer           *time.Timer
+	generation      int
+}
+
+// NewLineCoalescer returns a coalescer that joins each line with the lines
+// after it that match continuation, and passes them to messageCallback as one
+// message. A message is passed on once a line that does not match arrives,
+// it has maxLines lines, or no line arrives for flushTimeout. When
+// continuation is nil, every line is its own message.
+func NewLineCoalescer(continuation *regexp.Regexp, maxLines int, flushTimeout time.Duration, messageCallback func(string)) *LineCoalescer {
+	return &LineCoalescer{
+		continuation:    continuation,
+		maxLines:        maxLines,
+		flushTimeout:    flushTimeout,
+		messageCallback: messageCallback,
+	}
+}
+
+func (c *LineCoalescer) AddLine(line string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.continuation == nil {
+		c.messageCallback(line)
+		return
+	}
+
+	if len(c.lines) == 0 || !c.continuation.MatchString(line) {
+		c.flush()
+	}
+	c.lines = append(c.lines, line)
+
+	if len(c.lines) >= c.maxLines {
+		c.flush()
+		return
+	}
+
+	// A timer that has already fired may be waiting for the lock; the
+	// generation keeps it from flushing lines added after it was replaced.
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.generation++
+	generation := c.generation
+	c.timer = time.AfterFunc(c.flushTimeout, func() {
+		c.Lock()
+		defer c.Unlock()
+		if c.generation == generation {
+			c.flush()
+		}
+	})
+}
+
+// Flush passes on the lines held back waiting for continuation lines.
+func (c *LineCoalescer) Flush() {
+	c.Lock()
+	defer c.Unlock()
+	c.flush()
+}
+
+// Scan adds each line read from reader, flushing once it ends.
+func (c *LineCoalescer) Scan(reader io.Reader) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		c.AddLine(scanner.Text())
+	}
+	c.Flush()
+}
+
+func (c *LineCoalescer) flush() {
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	if len(c.lines) == 0 {
+		return
+	}
+
+	message := strings.Join(c.lines, "\n")
+	c.lines = nil
+	c.messageCallback(message)
+}
@@ -0,0 +1,13 @@
+package line_coalescer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLineCoalescer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LineCoalescer Suite")
+}
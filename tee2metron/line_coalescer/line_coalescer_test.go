@@ -0,0 +1,97 @@
+package line_coalescer_test
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/line_coalescer"
+)
+
+type messageReceiver struct {
+	sync.Mutex
+	messages []string
+}
+
+func (r *messageReceiver) AppendMessage(message string) {
+	r.Lock()
+	defer r.Unlock()
+	r.messages = append(r.messages, message)
+}
+
+func (r *messageReceiver) GetMessages() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string{}, r.messages...)
+}
+
+const goPanic = `panic: runtime error: invalid memory address or nil pointer dereference
+[signal 0xb code=0x1 addr=0x0 pc=0x4011f2]
+
+goroutine 1 [running]:
+main.main()
+	/var/lattice/src/rep/main.go:12 +0x42
+
+goroutine 5 [chan receive]:
+github.com/cloudfoundry-incubator/rep.(*Rep).Run(0xc20800a0c0, 0xc20803e060)
+	/var/lattice/src/rep/rep.go:40 +0x4d
+created by main.main
+	/var/lattice/src/rep/main.go:10 +0x30`
+
+var _ = Describe("LineCoalescer", func() {
+	var receiver *messageReceiver
+
+	BeforeEach(func() {
+		receiver = &messageReceiver{}
+	})
+
+	It("joins a Go panic into a single message", func() {
+		coalescer := line_coalescer.NewLineCoalescer(line_coalescer.GoPanicContinuation, 500, time.Minute, receiver.AppendMessage)
+
+		coalescer.Scan(strings.NewReader("starting rep\n" + goPanic + "\nexiting\n"))
+
+		Expect(receiver.GetMessages()).To(Equal([]string{"starting rep", goPanic, "exiting"}))
+	})
+
+	It("does not join ordinary log lines", func() {
+		coalescer := line_coalescer.NewLineCoalescer(line_coalescer.GoPanicContinuation, 500, time.Minute, receiver.AppendMessage)
+
+		coalescer.Scan(strings.NewReader(`{"timestamp":"1","source":"rep","message":"rep.started"}` + "\nStarting server (port 8080)\n"))
+
+		Expect(receiver.GetMessages()).To(Equal([]string{`{"timestamp":"1","source":"rep","message":"rep.started"}`, "Starting server (port 8080)"}))
+	})
+
+	It("sends a message once it has the maximum number of lines", func() {
+		coalescer := line_coalescer.NewLineCoalescer(regexp.MustCompile(`^\s`), 2, time.Minute, receiver.AppendMessage)
+
+		coalescer.AddLine("error:")
+		coalescer.AddLine("  one")
+		Expect(receiver.GetMessages()).To(Equal([]string{"error:\n  one"}))
+
+		coalescer.AddLine("  two")
+		coalescer.Flush()
+		Expect(receiver.GetMessages()).To(Equal([]string{"error:\n  one", "  two"}))
+	})
+
+	It("sends a message once no line has arrived for the flush timeout", func() {
+		coalescer := line_coalescer.NewLineCoalescer(regexp.MustCompile(`^\s`), 500, 50*time.Millisecond, receiver.AppendMessage)
+
+		coalescer.AddLine("error:")
+		coalescer.AddLine("  details")
+		Consistently(receiver.GetMessages, 30*time.Millisecond).Should(BeEmpty())
+
+		Eventually(receiver.GetMessages).Should(Equal([]string{"error:\n  details"}))
+	})
+
+	It("sends every line on its own without a continuation pattern", func() {
+		coalescer := line_coalescer.NewLineCoalescer(nil, 500, time.Minute, receiver.AppendMessage)
+
+		coalescer.Scan(strings.NewReader("error:\n  details\n"))
+
+		Expect(receiver.GetMessages()).To(Equal([]string{"error:", "  details"}))
+	})
+})
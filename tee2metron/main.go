@@ -2,18 +2,75 @@ package main
 
 import (
 	"flag"
-	"github.com/cloudfoundry/dropsonde"
-	"github.com/cloudfoundry/dropsonde/logs"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
-    "fmt"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/file_tailer"
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/line_coalescer"
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/metron"
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/process_stats"
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/spool"
+	"github.com/cloudfoundry/dropsonde"
+	"github.com/cloudfoundry/dropsonde/logs"
+	"github.com/cloudfoundry/dropsonde/metrics"
 )
 
 var dropsondeDestination, sourceInstance string
+var logGuid, sourceType, stdoutSourceType, stderrSourceType string
+var tailFiles stringsFlag
+var metricsInterval time.Duration
+var multilinePattern string
+var multilineMaxLines int
+var multilineFlushTimeout time.Duration
+var spoolSize int
+var spoolFile string
+var spoolProbeInterval time.Duration
+
+// sendLog sends a log message to metron. With -spoolSize, it spools messages
+// while metron is unreachable.
+var sendLog = sendWithDropsonde
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
 
 const latticeDebugStreamId = "lattice-debug"
 
+// logFlushTimeout bounds how long tee2metron waits, once the command has
+// exited, for its remaining output to be sent to metron.
+const logFlushTimeout = 5 * time.Second
+
+// tailPollInterval is how often files passed with -tailFile are checked for
+// new lines.
+const tailPollInterval = 250 * time.Millisecond
+
+// forwardedSignals are passed on to the command's process group, so that
+// stopping tee2metron stops the component it wraps.
+var forwardedSignals = []os.Signal{
+	syscall.SIGTERM,
+	syscall.SIGINT,
+	syscall.SIGHUP,
+	syscall.SIGQUIT,
+	syscall.SIGUSR1,
+	syscall.SIGUSR2,
+}
+
 func init() {
 	flag.StringVar(
 		&dropsondeDestination,
@@ -29,53 +86,364 @@ func init() {
 		"",
 		"The label for the log source instance that shows up when consuming the stream",
 	)
-	flag.Parse()
+
+	flag.StringVar(
+		&logGuid,
+		"logGuid",
+		latticeDebugStreamId,
+		`the log GUID to stream logs to. Use an app name to read them with ltc logs
+    eg. -logGuid=my-host-service
+    `)
+
+	flag.StringVar(
+		&sourceType,
+		"sourceType",
+		"",
+		"The source type that shows up when consuming the stream (defaults to the command)",
+	)
+
+	flag.StringVar(
+		&stdoutSourceType,
+		"stdoutSourceType",
+		"",
+		"The source type for the command's stdout (defaults to -sourceType)",
+	)
+
+	flag.StringVar(
+		&stderrSourceType,
+		"stderrSourceType",
+		"",
+		"The source type for the command's stderr (defaults to -sourceType)",
+	)
+
+	flag.Var(
+		&tailFiles,
+		"tailFile",
+		`a log file to follow, handling rotation and truncation. Can be passed multiple times, with or without a command
+    eg. -tailFile=/var/lattice/log/rep-service.log
+    `)
+
+	flag.DurationVar(
+		&metricsInterval,
+		"metricsInterval",
+		30*time.Second,
+		"How often to emit the command's CPU time, memory, open files and uptime as metrics, with -sourceInstance in their origin (0 disables them)",
+	)
+
+	flag.StringVar(
+		&multilinePattern,
+		"multilinePattern",
+		line_coalescer.GoPanicContinuation.String(),
+		"Lines matching this regular expression are joined to the line before them into one message. The default joins Go panics; an empty pattern sends every line on its own",
+	)
+
+	flag.IntVar(
+		&multilineMaxLines,
+		"multilineMaxLines",
+		500,
+		"The most lines joined into one message",
+	)
+
+	flag.DurationVar(
+		&multilineFlushTimeout,
+		"multilineFlushTimeout",
+		500*time.Millisecond,
+		"How long to wait for further lines of a message before sending it",
+	)
+
+	flag.IntVar(
+		&spoolSize,
+		"spoolSize",
+		0,
+		"Spool up to this many log messages while metron is unreachable, and send them with their original timestamps once it is back (0 disables spooling)",
+	)
+
+	flag.StringVar(
+		&spoolFile,
+		"spoolFile",
+		"",
+		"Also keep spooled log messages in this file, so that they survive restarts",
+	)
+
+	flag.DurationVar(
+		&spoolProbeInterval,
+		"spoolProbeInterval",
+		time.Second,
+		"How often to check whether metron is reachable while spooling",
+	)
 }
 
 func main() {
-    if dropsondeDestination == ""{
-        fmt.Println("dropsondeDestination flag is required")
-        os.Exit(1)
-    }
-    if sourceInstance == ""{
-        fmt.Println("sourceInstance flag is required")
-        os.Exit(1)
-    }
+	flag.Parse()
+
+	if dropsondeDestination == "" {
+		fmt.Println("dropsondeDestination flag is required")
+		os.Exit(1)
+	}
+	if sourceInstance == "" {
+		fmt.Println("sourceInstance flag is required")
+		os.Exit(1)
+	}
+
+	continuation, err := compileMultilinePattern(multilinePattern)
+	if err != nil {
+		fmt.Println("Invalid multilinePattern: " + err.Error())
+		os.Exit(1)
+	}
 
 	args := flag.Args()
 
-    if len(args) == 0 {
-        fmt.Println("Command not specified!")
-        fmt.Println("Usage: tee2metron -dropsondeDestionation=127.0.0.1:3457 -sourceInstance=lattice-cell-21 COMMAND")
-        os.Exit(3)
-    }
-	err := dropsonde.Initialize(dropsondeDestination, sourceInstance, args[0])
+	if len(args) == 0 && len(tailFiles) == 0 {
+		fmt.Println("Command not specified!")
+		fmt.Println("Usage: tee2metron -dropsondeDestionation=127.0.0.1:3457 -sourceInstance=lattice-cell-21 COMMAND")
+		fmt.Println("       tee2metron -dropsondeDestionation=127.0.0.1:3457 -sourceInstance=lattice-cell-21 -tailFile=FILE [-tailFile=FILE...] [COMMAND]")
+		os.Exit(3)
+	}
+
+	origin := "tee2metron"
+	if len(args) > 0 {
+		origin = args[0]
+	}
+	err = dropsonde.Initialize(dropsondeDestination, sourceInstance, origin)
 
 	if err != nil {
 		panic("error initializing dropsonde" + err.Error())
 	}
 
+	stopSpooling := make(chan struct{})
+	spoolingDone := &sync.WaitGroup{}
+	if spoolSize > 0 {
+		spoolingSender, err := newSpoolingSender(sourceInstance + "/" + origin)
+		if err != nil {
+			fmt.Println("Error setting up the spool: " + err.Error())
+			os.Exit(1)
+		}
+		sendLog = spoolingSender.Send
+
+		spoolingDone.Add(1)
+		go func() {
+			defer spoolingDone.Done()
+			spoolingSender.Run(stopSpooling)
+		}()
+	}
+
+	senders := &sync.WaitGroup{}
+	stopTailing := make(chan struct{})
+	for _, path := range tailFiles {
+		senders.Add(1)
+		go func(path string) {
+			defer senders.Done()
+			tailFile(path, continuation, stopTailing)
+		}(path)
+	}
+
+	exitStatus := 0
+	if len(args) > 0 {
+		exitStatus = runCommand(args, continuation, senders)
+	} else {
+		waitForSignal()
+	}
+
+	close(stopTailing)
+	flushLogs(senders, logFlushTimeout)
+	close(stopSpooling)
+	flushLogs(spoolingDone, logFlushTimeout)
+
+	os.Exit(exitStatus)
+}
+
+// runCommand runs the command until it exits, teeing its output to metron,
+// and returns its exit code. The senders wait group is done once all of the
+// output has been sent.
+func runCommand(args []string, continuation *regexp.Regexp, senders *sync.WaitGroup) int {
+	commandSourceType := sourceType
+	if commandSourceType == "" {
+		commandSourceType = args[0]
+	}
+	if stdoutSourceType == "" {
+		stdoutSourceType = commandSourceType
+	}
+	if stderrSourceType == "" {
+		stderrSourceType = commandSourceType
+	}
+
 	dropsondeStdoutReader, dropsondeStdoutWriter := io.Pipe()
 	dropsondeStderrReader, dropsondeStderrWriter := io.Pipe()
 
 	stdoutTeeWriter := io.MultiWriter(dropsondeStdoutWriter, os.Stdout)
 	stderrTeeWriter := io.MultiWriter(dropsondeStderrWriter, os.Stderr)
 
-	defer dropsondeStdoutReader.Close()
-	defer dropsondeStderrReader.Close()
-	defer dropsondeStdoutWriter.Close()
-	defer dropsondeStderrWriter.Close()
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Stdout = stdoutTeeWriter
 	cmd.Stderr = stderrTeeWriter
-	go logs.ScanLogStream(latticeDebugStreamId, args[0], sourceInstance, dropsondeStdoutReader)
-	go logs.ScanErrorLogStream(latticeDebugStreamId, args[0], sourceInstance, dropsondeStderrReader)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 
-	err = cmd.Start()
+	senders.Add(2)
+	go func() {
+		defer senders.Done()
+		line_coalescer.NewLineCoalescer(continuation, multilineMaxLines, multilineFlushTimeout, func(message string) {
+			sendLog(newLogMessage(message, stdoutSourceType, false))
+		}).Scan(dropsondeStdoutReader)
+	}()
+	go func() {
+		defer senders.Done()
+		line_coalescer.NewLineCoalescer(continuation, multilineMaxLines, multilineFlushTimeout, func(message string) {
+			sendLog(newLogMessage(message, stderrSourceType, true))
+		}).Scan(dropsondeStderrReader)
+	}()
+
+	signals := make(chan os.Signal, len(forwardedSignals))
+	signal.Notify(signals, forwardedSignals...)
+
+	err := cmd.Start()
 	if err != nil {
-        fmt.Println(err)
-        os.Exit(3)
-    }
+		fmt.Println(err)
+		os.Exit(3)
+	}
+
+	go forwardSignals(signals, cmd.Process.Pid)
+
+	// upstart respawns components that exit, so a rising count of starts
+	// means the component is restarting.
+	metrics.IncrementCounter("processStarts")
+	stopReporting := make(chan struct{})
+	if metricsInterval > 0 {
+		go reportProcessStats(cmd.Process.Pid, time.Now(), stopReporting)
+	}
 
 	cmd.Wait()
+	signal.Stop(signals)
+	close(stopReporting)
+
+	dropsondeStdoutWriter.Close()
+	dropsondeStderrWriter.Close()
+
+	return exitCode(cmd.ProcessState)
+}
+
+// tailFile sends the lines appended to the file at path to metron until
+// stopChan is closed. Their source type defaults to the file name without its
+// extension, e.g. rep-service for /var/lattice/log/rep-service.log.
+func tailFile(path string, continuation *regexp.Regexp, stopChan <-chan struct{}) {
+	fileSourceType := sourceType
+	if fileSourceType == "" {
+		fileSourceType = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	coalescer := line_coalescer.NewLineCoalescer(continuation, multilineMaxLines, multilineFlushTimeout, func(message string) {
+		sendLog(newLogMessage(message, fileSourceType, false))
+	})
+	file_tailer.Tail(path, tailPollInterval, coalescer.AddLine, stopChan)
+	coalescer.Flush()
+}
+
+func newLogMessage(text, sourceType string, isError bool) spool.Message {
+	return spool.Message{
+		AppId:          logGuid,
+		SourceType:     sourceType,
+		SourceInstance: sourceInstance,
+		Error:          isError,
+		Text:           text,
+		Timestamp:      time.Now().UnixNano(),
+	}
+}
+
+func sendWithDropsonde(message spool.Message) {
+	if message.Error {
+		logs.SendAppErrorLog(message.AppId, message.Text, message.SourceType, message.SourceInstance)
+	} else {
+		logs.SendAppLog(message.AppId, message.Text, message.SourceType, message.SourceInstance)
+	}
+}
+
+// newSpoolingSender sends log messages with the given dropsonde origin,
+// probing metron for a tenth of the probe interval.
+func newSpoolingSender(origin string) (*spool.SpoolingSender, error) {
+	messageSpool, err := spool.NewSpool(spoolSize, spoolFile)
+	if err != nil {
+		return nil, err
+	}
+
+	udpSender, err := metron.NewUDPSender(dropsondeDestination, origin, spoolProbeInterval/10)
+	if err != nil {
+		return nil, err
+	}
+
+	return spool.NewSpoolingSender(udpSender, messageSpool, spoolProbeInterval), nil
+}
+
+func compileMultilinePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" || multilineMaxLines <= 1 {
+		return nil, nil
+	}
+	return regexp.Compile(pattern)
+}
+
+func reportProcessStats(pid int, startedAt time.Time, stopChan <-chan struct{}) {
+	ticker := time.NewTicker(metricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+		}
+
+		stats, err := process_stats.Read("/proc", pid)
+		if err != nil {
+			continue
+		}
+
+		metrics.SendValue("processCpuTime", stats.CPUTime.Seconds(), "s")
+		metrics.SendValue("processRss", float64(stats.RSSBytes), "Bytes")
+		metrics.SendValue("processOpenFileDescriptors", float64(stats.OpenFileDescriptors), "count")
+		metrics.SendValue("processUptime", time.Since(startedAt).Seconds(), "s")
+	}
+}
+
+func waitForSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+	<-signals
+	signal.Stop(signals)
+}
+
+func forwardSignals(signals <-chan os.Signal, pid int) {
+	for sig := range signals {
+		syscall.Kill(-pid, sig.(syscall.Signal))
+	}
+}
+
+// flushLogs waits for the log scanners to send the command's remaining
+// output, giving up after timeout.
+func flushLogs(scanners *sync.WaitGroup, timeout time.Duration) {
+	flushed := make(chan struct{})
+	go func() {
+		scanners.Wait()
+		close(flushed)
+	}()
+
+	select {
+	case <-flushed:
+	case <-time.After(timeout):
+		fmt.Fprintln(os.Stderr, "Timed out sending the remaining logs to metron")
+	}
+}
+
+// exitCode returns the command's exit status, or 128 plus the signal number
+// when the command was killed by a signal, as a shell would.
+func exitCode(state *os.ProcessState) int {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok {
+		if state.Success() {
+			return 0
+		}
+		return 1
+	}
+
+	if status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return status.ExitStatus()
 }
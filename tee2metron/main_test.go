@@ -6,8 +6,13 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/gexec"
+	"io/ioutil"
 	"net"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
 
 	"regexp"
 	//    "errors""strings"
@@ -46,6 +51,156 @@ var _ = Describe("tee2metron", func() {
 		Eventually(gbytes.BufferWithBytes(*metronReceivedBuffer), 5).Should(gbytes.Say("Oopsie from stderr"))
 	})
 
+	It("streams to the given log GUID with the given source types", func() {
+		metronReceivedBuffer, port := startFakeMetron()
+		dropsondeDestinationFlag := "-dropsondeDestination=127.0.0.1:" + port
+		command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "-logGuid=my-host-service", "-stdoutSourceType=HOST_OUT", "-stderrSourceType=HOST_ERR", chattyProcessPath)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Kill()
+
+		Eventually(gbytes.BufferWithBytes(*metronReceivedBuffer)).Should(gbytes.Say("my-host-service"))
+		Eventually(gbytes.BufferWithBytes(*metronReceivedBuffer), 5).Should(gbytes.Say("HOST_OUT"))
+		Eventually(gbytes.BufferWithBytes(*metronReceivedBuffer), 5).Should(gbytes.Say("HOST_ERR"))
+		Expect(string(*metronReceivedBuffer)).ToNot(ContainSubstring("lattice-debug"))
+	})
+
+	It("follows log files without a command, exiting cleanly when stopped", func() {
+		tmpDir, err := ioutil.TempDir("", "tee2metron")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(tmpDir)
+		logPath := filepath.Join(tmpDir, "rep-service.log")
+		Expect(ioutil.WriteFile(logPath, []byte("old line\n"), 0644)).To(Succeed())
+
+		metronReceivedBuffer, port := startFakeMetron()
+		dropsondeDestinationFlag := "-dropsondeDestination=127.0.0.1:" + port
+		command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "-tailFile="+logPath)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		Consistently(session.Exited).ShouldNot(BeClosed())
+
+		logFile, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND, 0644)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = logFile.WriteString("a line from the file\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logFile.Close()).To(Succeed())
+
+		Eventually(gbytes.BufferWithBytes(*metronReceivedBuffer), 5).Should(gbytes.Say("a line from the file"))
+		Expect(string(*metronReceivedBuffer)).To(ContainSubstring("rep-service"))
+		Expect(string(*metronReceivedBuffer)).To(ContainSubstring("lattice-debug"))
+
+		session.Terminate()
+		Eventually(session).Should(gexec.Exit(0))
+	})
+
+	It("emits metrics for the command's process", func() {
+		metronPackets, port := startRecordingFakeMetron()
+		dropsondeDestinationFlag := "-dropsondeDestination=127.0.0.1:" + port
+		command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "-metricsInterval=50ms", "sleep", "10")
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Kill()
+
+		Eventually(metronPackets, 5).Should(gbytes.Say("processStarts"))
+		for _, metricName := range []string{"processCpuTime", "processRss", "processOpenFileDescriptors", "processUptime"} {
+			Eventually(metronPackets, 5).Should(gbytes.Say("%s", regexp.QuoteMeta(metricName)))
+		}
+		Expect(metronPackets.Contents()).To(ContainSubstring("lattice-cell-123"))
+	})
+
+	It("sends multi-line output such as Go panics as one message", func() {
+		metronPackets, port := startRecordingFakeMetron()
+		dropsondeDestinationFlag := "-dropsondeDestination=127.0.0.1:" + port
+		command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "-multilineFlushTimeout=50ms", "sh", "-c", `echo "panic: boom"; echo; echo "goroutine 1 [running]:"; echo "main.main()"; sleep 1`)
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Kill()
+
+		Eventually(metronPackets.Contents, 5).Should(ContainSubstring("panic: boom\n\ngoroutine 1 [running]:\nmain.main()"))
+	})
+
+	It("rejects an invalid multiline pattern", func() {
+		command := exec.Command(tee2MetronPath, "-dropsondeDestination=127.0.0.1:4000", "-sourceInstance=lattice-cell-123", "-multilinePattern=(", "true")
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(session).Should(gexec.Exit(1))
+		Expect(session.Out).To(gbytes.Say("Invalid multilinePattern"))
+	})
+
+	It("spools logs while metron is unreachable and sends them once it is back", func() {
+		reservedPort, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		metronAddress := reservedPort.LocalAddr().String()
+		Expect(reservedPort.Close()).To(Succeed())
+
+		command := exec.Command(tee2MetronPath, "-dropsondeDestination="+metronAddress, "-sourceInstance=lattice-cell-123", "-spoolSize=100", "-spoolProbeInterval=100ms", "sh", "-c", "sleep 0.5; echo spooled line; sleep 10")
+		session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Kill()
+		Eventually(session.Out).Should(gbytes.Say("spooled line"))
+		time.Sleep(300 * time.Millisecond)
+
+		connection, err := net.ListenPacket("udp", metronAddress)
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		metronPackets := gbytes.NewBuffer()
+		go func() {
+			packet := make([]byte, maxUpdDatagramSize)
+			for {
+				n, _, err := connection.ReadFrom(packet)
+				if err != nil {
+					return
+				}
+				metronPackets.Write(packet[:n])
+			}
+		}()
+
+		Eventually(metronPackets, 5).Should(gbytes.Say("spooled line"))
+	})
+
+	Describe("supervising the command", func() {
+		var dropsondeDestinationFlag string
+
+		BeforeEach(func() {
+			_, port := startFakeMetron()
+			dropsondeDestinationFlag = "-dropsondeDestination=127.0.0.1:" + port
+		})
+
+		It("exits with the command's exit code", func() {
+			command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "sh", "-c", "echo bye; exit 7")
+			session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(session).Should(gexec.Exit(7))
+			Expect(session.Out).To(gbytes.Say("bye"))
+		})
+
+		It("forwards signals to the command's process group", func() {
+			command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", "sh", "-c", `trap "echo stopping; exit 5" TERM; echo started; while true; do sleep 0.1; done`)
+			session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(session.Out).Should(gbytes.Say("started"))
+
+			session.Terminate()
+
+			Eventually(session.Out).Should(gbytes.Say("stopping"))
+			Eventually(session).Should(gexec.Exit(5))
+		})
+
+		It("exits with 128 plus the signal when the command is killed by one", func() {
+			command := exec.Command(tee2MetronPath, dropsondeDestinationFlag, "-sourceInstance=lattice-cell-123", chattyProcessPath)
+			session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(session.Out).Should(gbytes.Say("Hi from stdout"))
+
+			session.Interrupt()
+
+			Eventually(session).Should(gexec.Exit(128 + int(syscall.SIGINT)))
+		})
+	})
+
     Context("With a bad command", func(){
         Context("when the command is missing", func(){
             It("prints and error message and exits", func(){
@@ -123,3 +278,28 @@ func startFakeMetron() (metronReceivedBufferPtr *[]byte, port string) {
 
 	return &metronReceivedBuffer, port
 }
+
+// startRecordingFakeMetron keeps every packet it receives, where
+// startFakeMetron keeps only the latest.
+func startRecordingFakeMetron() (metronPackets *gbytes.Buffer, port string) {
+	connection, err := net.ListenPacket("udp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+
+	metronPackets = gbytes.NewBuffer()
+	go func() {
+		defer connection.Close()
+		packet := make([]byte, maxUpdDatagramSize)
+		for {
+			n, _, err := connection.ReadFrom(packet)
+			if err != nil {
+				return
+			}
+			metronPackets.Write(packet[:n])
+		}
+	}()
+
+	_, port, err = net.SplitHostPort(connection.LocalAddr().String())
+	Expect(err).ToNot(HaveOccurred())
+
+	return metronPackets, port
+}
@@ -0,0 +1,96 @@
+package metron
+
+import (
+	"net"
+	"time"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/spool"
+	"github.com/cloudfoundry/dropsonde/events"
+	"github.com/gogo/protobuf/proto"
+)
+
+// SpooledMessagesMetric is the value metric sent by each probe.
+const SpooledMessagesMetric = "spooledLogMessages"
+
+// UDPSender sends log messages to metron with their original timestamps.
+//
+// Its socket is connected, so that once metron's port has been found closed,
+// through an ICMP port unreachable, the next write fails instead of being
+// silently dropped. A probe sends a value metric and waits probeTimeout for
+// such a failure.
+type UDPSender struct {
+	conn         net.Conn
+	origin       string
+	probeTimeout time.Duration
+}
+
+func NewUDPSender(destination, origin string, probeTimeout time.Duration) (*UDPSender, error) {
+	conn, err := net.Dial("udp", destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UDPSender{
+		conn:         conn,
+		origin:       origin,
+		probeTimeout: probeTimeout,
+	}, nil
+}
+
+func (s *UDPSender) Send(message spool.Message) error {
+	messageType := events.LogMessage_OUT
+	if message.Error {
+		messageType = events.LogMessage_ERR
+	}
+
+	return s.write(&events.Envelope{
+		Origin:    proto.String(s.origin),
+		EventType: events.Envelope_LogMessage.Enum(),
+		Timestamp: proto.Int64(message.Timestamp),
+		LogMessage: &events.LogMessage{
+			Message:        []byte(message.Text),
+			MessageType:    messageType.Enum(),
+			Timestamp:      proto.Int64(message.Timestamp),
+			AppId:          proto.String(message.AppId),
+			SourceType:     proto.String(message.SourceType),
+			SourceInstance: proto.String(message.SourceInstance),
+		},
+	})
+}
+
+func (s *UDPSender) Probe(spooledMessages int) error {
+	err := s.write(&events.Envelope{
+		Origin:    proto.String(s.origin),
+		EventType: events.Envelope_ValueMetric.Enum(),
+		Timestamp: proto.Int64(time.Now().UnixNano()),
+		ValueMetric: &events.ValueMetric{
+			Name:  proto.String(SpooledMessagesMetric),
+			Value: proto.Float64(float64(spooledMessages)),
+			Unit:  proto.String("count"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.conn.SetReadDeadline(time.Now().Add(s.probeTimeout))
+	_, err = s.conn.Read(make([]byte, 1))
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return nil
+	}
+	return err
+}
+
+func (s *UDPSender) Close() error {
+	return s.conn.Close()
+}
+
+func (s *UDPSender) write(envelope *events.Envelope) error {
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.conn.Write(data)
+	return err
+}
@@ -0,0 +1,87 @@
+package metron_test
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/metron"
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/spool"
+	"github.com/cloudfoundry/dropsonde/events"
+	"github.com/gogo/protobuf/proto"
+)
+
+var _ = Describe("UDPSender", func() {
+	var (
+		listener net.PacketConn
+		sender   *metron.UDPSender
+	)
+
+	receiveEnvelope := func() *events.Envelope {
+		packet := make([]byte, 65507)
+		listener.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := listener.ReadFrom(packet)
+		Expect(err).ToNot(HaveOccurred())
+
+		envelope := &events.Envelope{}
+		Expect(proto.Unmarshal(packet[:n], envelope)).To(Succeed())
+		return envelope
+	}
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		sender, err = metron.NewUDPSender(listener.LocalAddr().String(), "lattice-cell-01/rep", 50*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		sender.Close()
+		listener.Close()
+	})
+
+	It("sends log messages with their original timestamps", func() {
+		Expect(sender.Send(spool.Message{
+			AppId:          "lattice-debug",
+			SourceType:     "rep",
+			SourceInstance: "lattice-cell-01",
+			Error:          true,
+			Text:           "rep.failed",
+			Timestamp:      42,
+		})).To(Succeed())
+
+		envelope := receiveEnvelope()
+		Expect(envelope.GetOrigin()).To(Equal("lattice-cell-01/rep"))
+		Expect(envelope.GetEventType()).To(Equal(events.Envelope_LogMessage))
+		Expect(envelope.GetTimestamp()).To(Equal(int64(42)))
+
+		logMessage := envelope.GetLogMessage()
+		Expect(string(logMessage.GetMessage())).To(Equal("rep.failed"))
+		Expect(logMessage.GetMessageType()).To(Equal(events.LogMessage_ERR))
+		Expect(logMessage.GetTimestamp()).To(Equal(int64(42)))
+		Expect(logMessage.GetAppId()).To(Equal("lattice-debug"))
+		Expect(logMessage.GetSourceType()).To(Equal("rep"))
+		Expect(logMessage.GetSourceInstance()).To(Equal("lattice-cell-01"))
+	})
+
+	Describe("Probe", func() {
+		It("reports the spooled messages and succeeds while metron is listening", func() {
+			Expect(sender.Probe(3)).To(Succeed())
+
+			envelope := receiveEnvelope()
+			Expect(envelope.GetEventType()).To(Equal(events.Envelope_ValueMetric))
+			Expect(envelope.GetValueMetric().GetName()).To(Equal(metron.SpooledMessagesMetric))
+			Expect(envelope.GetValueMetric().GetValue()).To(Equal(3.0))
+		})
+
+		It("fails once metron has stopped listening", func() {
+			listener.Close()
+
+			Expect(sender.Probe(0)).ToNot(Succeed())
+		})
+	})
+})
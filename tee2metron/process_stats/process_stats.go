@@ -0,0 +1,78 @@
+package process_stats
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ClockTicksPerSecond is the kernel's USER_HZ, the unit of the CPU times in
+// /proc/PID/stat. It is 100 on every Linux platform Lattice runs on.
+const ClockTicksPerSecond = 100
+
+type ProcessStats struct {
+	CPUTime             time.Duration
+	RSSBytes            uint64
+	OpenFileDescriptors int
+}
+
+// Read returns the stats of the process pid from procDir, normally /proc.
+func Read(procDir string, pid int) (ProcessStats, error) {
+	pidDir := filepath.Join(procDir, strconv.Itoa(pid))
+
+	stat, err := ioutil.ReadFile(filepath.Join(pidDir, "stat"))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+
+	stats, err := parseStat(string(stat))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+
+	fds, err := ioutil.ReadDir(filepath.Join(pidDir, "fd"))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	stats.OpenFileDescriptors = len(fds)
+
+	return stats, nil
+}
+
+// parseStat reads utime, stime and rss from the contents of /proc/PID/stat.
+// The command name in parentheses may itself contain spaces and parentheses,
+// so fields are counted from the last closing parenthesis.
+func parseStat(stat string) (ProcessStats, error) {
+	commEnd := strings.LastIndex(stat, ")")
+	if commEnd < 0 {
+		return ProcessStats{}, fmt.Errorf("malformed stat: %q", stat)
+	}
+
+	// fields[0] is the state, the third field of the file.
+	fields := strings.Fields(stat[commEnd+1:])
+	if len(fields) < 22 {
+		return ProcessStats{}, fmt.Errorf("malformed stat: %q", stat)
+	}
+
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return ProcessStats{}, fmt.Errorf("malformed utime: %s", err)
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return ProcessStats{}, fmt.Errorf("malformed stime: %s", err)
+	}
+	rssPages, err := strconv.ParseUint(fields[21], 10, 64)
+	if err != nil {
+		return ProcessStats{}, fmt.Errorf("malformed rss: %s", err)
+	}
+
+	return ProcessStats{
+		CPUTime:  time.Duration(utime+stime) * time.Second / ClockTicksPerSecond,
+		RSSBytes: rssPages * uint64(os.Getpagesize()),
+	}, nil
+}
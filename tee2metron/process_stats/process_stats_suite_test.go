@@ -0,0 +1,13 @@
+package process_stats_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestProcessStats(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ProcessStats Suite")
+}
@@ -0,0 +1,64 @@
+package process_stats_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/process_stats"
+)
+
+var _ = Describe("Read", func() {
+	var procDir string
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(procDir, "42", "fd"), 0755)).To(Succeed())
+		for _, fd := range []string{"0", "1", "2"} {
+			Expect(ioutil.WriteFile(filepath.Join(procDir, "42", "fd", fd), nil, 0644)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(procDir)).To(Succeed())
+	})
+
+	It("reads the CPU time, resident memory and open file descriptors", func() {
+		stat := "42 (rep (lattice) 1) S 1 42 42 0 -1 4194304 79 0 0 0 250 50 0 0 20 0 1 0 416919 2703360 10 18446744073709551615 0 0 0 0 0\n"
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "42", "stat"), []byte(stat), 0644)).To(Succeed())
+
+		stats, err := process_stats.Read(procDir, 42)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(stats.CPUTime).To(Equal(3 * time.Second))
+		Expect(stats.RSSBytes).To(Equal(uint64(10 * os.Getpagesize())))
+		Expect(stats.OpenFileDescriptors).To(Equal(3))
+	})
+
+	It("returns an error for a malformed stat file", func() {
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "42", "stat"), []byte("42 (rep) S 1"), 0644)).To(Succeed())
+
+		_, err := process_stats.Read(procDir, 42)
+		Expect(err).To(MatchError(ContainSubstring("malformed stat")))
+	})
+
+	It("returns an error for a process that does not exist", func() {
+		_, err := process_stats.Read(procDir, 43)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads a running process from /proc", func() {
+		stats, err := process_stats.Read("/proc", os.Getpid())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(stats.RSSBytes).To(BeNumerically(">", 0))
+		Expect(stats.OpenFileDescriptors).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,171 @@
+package spool
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Message is a log message as it was received, so that it can be sent later
+// with its original timestamp.
+type Message struct {
+	AppId          string `json:"app_id"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	Error          bool   `json:"error,omitempty"`
+	Text           string `json:"text"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// Spool holds up to maxMessages messages, dropping the oldest when full.
+// When it has a path, messages are also written to that file, so that they
+// survive a restart.
+type Spool struct {
+	sync.Mutex
+	maxMessages int
+	path        string
+	file        *os.File
+	messages    []Message
+	dropped     uint64
+	fileLines   int
+}
+
+// NewSpool returns a spool holding the newest messages already in the file
+// at path. An empty path keeps messages in memory only.
+func NewSpool(maxMessages int, path string) (*Spool, error) {
+	spool := &Spool{maxMessages: maxMessages, path: path}
+	if path == "" {
+		return spool, nil
+	}
+
+	if err := spool.load(); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	spool.file = file
+
+	return spool, nil
+}
+
+func (s *Spool) Add(message Message) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.add(message)
+	if s.file == nil {
+		return
+	}
+
+	// Dropped messages stay in the file until it is rewritten, which keeps
+	// it to at most twice the spool's size.
+	if s.fileLines >= 2*s.maxMessages {
+		s.rewrite()
+		return
+	}
+	writeMessage(s.file, message)
+	s.fileLines++
+}
+
+func (s *Spool) Len() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.messages)
+}
+
+// Dropped returns how many messages were dropped because the spool was full.
+func (s *Spool) Dropped() uint64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.dropped
+}
+
+// Drain sends the messages in the order they were added, stopping at the
+// first one that cannot be sent and keeping it and the rest.
+func (s *Spool) Drain(send func(Message) error) error {
+	s.Lock()
+	defer s.Unlock()
+
+	for len(s.messages) > 0 {
+		if err := send(s.messages[0]); err != nil {
+			s.rewrite()
+			return err
+		}
+		s.messages = s.messages[1:]
+	}
+
+	s.rewrite()
+	return nil
+}
+
+func (s *Spool) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
+
+func (s *Spool) add(message Message) {
+	if s.maxMessages <= 0 {
+		s.dropped++
+		return
+	}
+	if len(s.messages) >= s.maxMessages {
+		s.messages = s.messages[1:]
+		s.dropped++
+	}
+	s.messages = append(s.messages, message)
+}
+
+func (s *Spool) load() error {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		s.fileLines++
+		var message Message
+		if json.Unmarshal(scanner.Bytes(), &message) == nil {
+			s.add(message)
+		}
+	}
+	s.dropped = 0
+
+	return scanner.Err()
+}
+
+// rewrite replaces the file's contents with the messages still spooled.
+func (s *Spool) rewrite() {
+	if s.file == nil {
+		return
+	}
+
+	if err := s.file.Truncate(0); err != nil {
+		return
+	}
+	for _, message := range s.messages {
+		writeMessage(s.file, message)
+	}
+	s.fileLines = len(s.messages)
+}
+
+func writeMessage(file *os.File, message Message) {
+	encoded, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+	file.Write(append(encoded, '\n'))
+}
@@ -0,0 +1,139 @@
+package spool_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/spool"
+)
+
+func message(text string, timestamp int64) spool.Message {
+	return spool.Message{AppId: "lattice-debug", SourceType: "rep", SourceInstance: "lattice-cell-01", Text: text, Timestamp: timestamp}
+}
+
+func drainAll(messageSpool *spool.Spool) []spool.Message {
+	var sent []spool.Message
+	Expect(messageSpool.Drain(func(message spool.Message) error {
+		sent = append(sent, message)
+		return nil
+	})).To(Succeed())
+	return sent
+}
+
+var _ = Describe("Spool", func() {
+	It("drains messages in the order they were added", func() {
+		messageSpool, err := spool.NewSpool(10, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		messageSpool.Add(message("first", 1))
+		messageSpool.Add(message("second", 2))
+		Expect(messageSpool.Len()).To(Equal(2))
+
+		Expect(drainAll(messageSpool)).To(Equal([]spool.Message{message("first", 1), message("second", 2)}))
+		Expect(messageSpool.Len()).To(BeZero())
+	})
+
+	It("drops the oldest messages when full", func() {
+		messageSpool, err := spool.NewSpool(2, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		messageSpool.Add(message("first", 1))
+		messageSpool.Add(message("second", 2))
+		messageSpool.Add(message("third", 3))
+
+		Expect(messageSpool.Dropped()).To(Equal(uint64(1)))
+		Expect(drainAll(messageSpool)).To(Equal([]spool.Message{message("second", 2), message("third", 3)}))
+	})
+
+	It("keeps the messages that could not be sent", func() {
+		messageSpool, err := spool.NewSpool(10, "")
+		Expect(err).ToNot(HaveOccurred())
+		messageSpool.Add(message("first", 1))
+		messageSpool.Add(message("second", 2))
+
+		sendErr := errors.New("connection refused")
+		err = messageSpool.Drain(func(message spool.Message) error {
+			if message.Text == "second" {
+				return sendErr
+			}
+			return nil
+		})
+
+		Expect(err).To(Equal(sendErr))
+		Expect(drainAll(messageSpool)).To(Equal([]spool.Message{message("second", 2)}))
+	})
+
+	Context("with a file", func() {
+		var tmpDir, spoolPath string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "spool")
+			Expect(err).ToNot(HaveOccurred())
+			spoolPath = filepath.Join(tmpDir, "rep.spool")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("keeps spooled messages across restarts", func() {
+			messageSpool, err := spool.NewSpool(10, spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			messageSpool.Add(message("first", 1))
+			messageSpool.Add(message("second", 2))
+			Expect(messageSpool.Close()).To(Succeed())
+
+			restartedSpool, err := spool.NewSpool(10, spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer restartedSpool.Close()
+
+			Expect(drainAll(restartedSpool)).To(Equal([]spool.Message{message("first", 1), message("second", 2)}))
+		})
+
+		It("empties the file once drained", func() {
+			messageSpool, err := spool.NewSpool(10, spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			messageSpool.Add(message("first", 1))
+			drainAll(messageSpool)
+			Expect(messageSpool.Close()).To(Succeed())
+
+			contents, err := ioutil.ReadFile(spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(BeEmpty())
+		})
+
+		It("keeps the file to at most twice the spool's size", func() {
+			messageSpool, err := spool.NewSpool(2, spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			for i := int64(0); i < 10; i++ {
+				messageSpool.Add(message("message", i))
+			}
+			Expect(messageSpool.Close()).To(Succeed())
+
+			contents, err := ioutil.ReadFile(spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countLines(contents)).To(BeNumerically("<=", 4))
+
+			restartedSpool, err := spool.NewSpool(2, spoolPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer restartedSpool.Close()
+			Expect(drainAll(restartedSpool)).To(Equal([]spool.Message{message("message", 8), message("message", 9)}))
+		})
+	})
+})
+
+func countLines(contents []byte) int {
+	lines := 0
+	for _, b := range contents {
+		if b == '\n' {
+			lines++
+		}
+	}
+	return lines
+}
@@ -0,0 +1,74 @@
+package spool
+
+import (
+	"sync"
+	"time"
+)
+
+type Sender interface {
+	Send(message Message) error
+
+	// Probe checks whether the destination is reachable, reporting how many
+	// messages are spooled for it.
+	Probe(spooledMessages int) error
+}
+
+// SpoolingSender sends messages while the destination is reachable, and
+// spools them while it is not. The destination is probed every
+// probeInterval, and the spool is drained once a probe succeeds.
+type SpoolingSender struct {
+	sync.Mutex
+	sender        Sender
+	spool         *Spool
+	probeInterval time.Duration
+	reachable     bool
+}
+
+func NewSpoolingSender(sender Sender, spool *Spool, probeInterval time.Duration) *SpoolingSender {
+	return &SpoolingSender{
+		sender:        sender,
+		spool:         spool,
+		probeInterval: probeInterval,
+		reachable:     true,
+	}
+}
+
+func (s *SpoolingSender) Send(message Message) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.reachable && s.spool.Len() == 0 {
+		if s.sender.Send(message) == nil {
+			return
+		}
+		s.reachable = false
+	}
+	s.spool.Add(message)
+}
+
+// Run probes the destination until stopChan is closed, then tries once more
+// to drain the spool.
+func (s *SpoolingSender) Run(stopChan <-chan struct{}) {
+	ticker := time.NewTicker(s.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopChan:
+			s.probeAndDrain()
+			return
+		case <-ticker.C:
+			s.probeAndDrain()
+		}
+	}
+}
+
+func (s *SpoolingSender) probeAndDrain() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.reachable = s.sender.Probe(s.spool.Len()) == nil
+	if s.reachable {
+		s.reachable = s.spool.Drain(s.sender.Send) == nil
+	}
+}
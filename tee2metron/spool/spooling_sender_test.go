@@ -0,0 +1,115 @@
+package spool_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/cloudfoundry-incubator/lattice/tee2metron/spool"
+)
+
+type fakeSender struct {
+	sync.Mutex
+	reachable bool
+	sent      []spool.Message
+	probes    []int
+}
+
+func (f *fakeSender) Send(message spool.Message) error {
+	f.Lock()
+	defer f.Unlock()
+	if !f.reachable {
+		return errors.New("connection refused")
+	}
+	f.sent = append(f.sent, message)
+	return nil
+}
+
+func (f *fakeSender) Probe(spooledMessages int) error {
+	f.Lock()
+	defer f.Unlock()
+	f.probes = append(f.probes, spooledMessages)
+	if !f.reachable {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (f *fakeSender) setReachable(reachable bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.reachable = reachable
+}
+
+func (f *fakeSender) getSent() []spool.Message {
+	f.Lock()
+	defer f.Unlock()
+	return append([]spool.Message{}, f.sent...)
+}
+
+func (f *fakeSender) getProbes() []int {
+	f.Lock()
+	defer f.Unlock()
+	return append([]int{}, f.probes...)
+}
+
+var _ = Describe("SpoolingSender", func() {
+	var (
+		sender         *fakeSender
+		messageSpool   *spool.Spool
+		spoolingSender *spool.SpoolingSender
+		stopChan       chan struct{}
+		doneChan       chan struct{}
+	)
+
+	BeforeEach(func() {
+		sender = &fakeSender{reachable: true}
+
+		var err error
+		messageSpool, err = spool.NewSpool(10, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		spoolingSender = spool.NewSpoolingSender(sender, messageSpool, 10*time.Millisecond)
+
+		stop, done := make(chan struct{}), make(chan struct{})
+		stopChan, doneChan = stop, done
+		go func() {
+			spoolingSender.Run(stop)
+			close(done)
+		}()
+	})
+
+	AfterEach(func() {
+		close(stopChan)
+		Eventually(doneChan).Should(BeClosed())
+	})
+
+	It("sends messages while the destination is reachable", func() {
+		spoolingSender.Send(message("first", 1))
+
+		Expect(sender.getSent()).To(Equal([]spool.Message{message("first", 1)}))
+		Expect(messageSpool.Len()).To(BeZero())
+	})
+
+	It("spools messages while the destination is unreachable and replays them in order once it is back", func() {
+		sender.setReachable(false)
+		spoolingSender.Send(message("first", 1))
+		Eventually(sender.getProbes).ShouldNot(BeEmpty())
+		spoolingSender.Send(message("second", 2))
+
+		Expect(sender.getSent()).To(BeEmpty())
+		Expect(messageSpool.Len()).To(Equal(2))
+		Eventually(sender.getProbes).Should(ContainElement(2))
+
+		sender.setReachable(true)
+
+		Eventually(sender.getSent).Should(Equal([]spool.Message{message("first", 1), message("second", 2)}))
+		Expect(messageSpool.Len()).To(BeZero())
+
+		spoolingSender.Send(message("third", 3))
+		Expect(sender.getSent()).To(Equal([]spool.Message{message("first", 1), message("second", 2), message("third", 3)}))
+	})
+})